@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sidkurella/gunion/internal/codegen"
+	"github.com/spf13/cobra"
+)
+
+// generatedHeaderPrefix starts the first line of every file gunion generates.
+const generatedHeaderPrefix = "// Code generated by gunion"
+
+// newCheckCmd creates the check subcommand, which takes the same flags and arguments as the root command.
+func newCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check [packages]",
+		Short: "Reports generated files that are out of date",
+		Long: `Regenerates unions in memory and compares them with the files on disk, without writing anything.
+
+A unified diff is printed for every missing or out-of-date file, and the command fails if there are any.
+The command recorded in each file's header is not compared, so the check can be run with different
+arguments than the ones that generated the files, e.g. gunion check ./...
+`,
+		Args:         cobra.ArbitraryArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ts, outCfgs, err := loadUnions(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			gen := GeneratorFactory(outCfgs...)
+			files, err := gen.Render(ts...)
+			if err != nil {
+				return fmt.Errorf("failed to generate code: %w", err)
+			}
+
+			stale := 0
+			for _, f := range files {
+				isStale, err := checkFile(cmd.OutOrStdout(), f)
+				if err != nil {
+					return err
+				}
+				if isStale {
+					stale++
+				}
+			}
+			if stale > 0 {
+				return fmt.Errorf("%d of %d generated files are out of date", stale, len(files))
+			}
+			return nil
+		},
+	}
+	setupFlags(cmd)
+	return cmd
+}
+
+// checkFile compares a generated file with the one on disk, and writes a unified diff to w if they differ.
+func checkFile(w io.Writer, f codegen.File) (bool, error) {
+	fromFile := f.Path
+	existing, err := os.ReadFile(f.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		fromFile = "/dev/null"
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read generated file: %w", err)
+	}
+
+	content := withHeaderOf(f.Content, existing)
+	if bytes.Equal(existing, content) {
+		return false, nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(string(content)),
+		FromFile: fromFile,
+		ToFile:   f.Path,
+		Context:  3,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to diff %s: %w", f.Path, err)
+	}
+	if _, err := io.WriteString(w, diff); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// withHeaderOf replaces the header line of the generated content with the existing file's,
+// if both are gunion headers, so that only the generated code itself is compared.
+func withHeaderOf(content, existing []byte) []byte {
+	existingHeader, _, ok := bytes.Cut(existing, []byte("\n"))
+	if !ok || !bytes.HasPrefix(existingHeader, []byte(generatedHeaderPrefix)) {
+		return content
+	}
+	header, rest, ok := bytes.Cut(content, []byte("\n"))
+	if !ok || !bytes.HasPrefix(header, []byte(generatedHeaderPrefix)) {
+		return content
+	}
+	return append(append(append([]byte{}, existingHeader...), '\n'), rest...)
+}
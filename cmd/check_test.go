@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	// Save and restore global state.
+	origGOFILE := os.Getenv("GOFILE")
+	origGOPACKAGE := os.Getenv("GOPACKAGE")
+	origArgs := os.Args
+	t.Cleanup(func() {
+		os.Setenv("GOFILE", origGOFILE)
+		os.Setenv("GOPACKAGE", origGOPACKAGE)
+		os.Args = origArgs
+	})
+
+	os.Setenv("GOFILE", "")
+	os.Setenv("GOPACKAGE", "")
+
+	srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "basic", "basic.go"))
+	require.NoError(t, err)
+	golden, err := os.ReadFile(filepath.Join("..", "internal", "testdata", "basic", "gen.go"))
+	require.NoError(t, err)
+
+	// check runs the check subcommand against outFile and returns its output.
+	check := func(t *testing.T, outFile string) (string, error) {
+		args := []string{
+			"check",
+			"--type", "myUnion",
+			"--src", srcAbs,
+			"--out-type", "MyUnionUnion",
+			"--out-pkg", "basic",
+			"--out-file", outFile,
+			"--no-default",
+		}
+		os.Args = append([]string{"gunion"}, args...)
+
+		out := &bytes.Buffer{}
+		cmd := newRootCmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("up-to-date file passes", func(t *testing.T) {
+		outFile := filepath.Join(t.TempDir(), "basic_gunion.go")
+		require.NoError(t, os.WriteFile(outFile, golden, 0644))
+
+		out, err := check(t, outFile)
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("header command is not compared", func(t *testing.T) {
+		outFile := filepath.Join(t.TempDir(), "basic_gunion.go")
+		header := "// Code generated by gunion via `gunion --type myUnion --no-default`. DO NOT EDIT."
+		_, rest, _ := strings.Cut(string(golden), "\n")
+		require.NoError(t, os.WriteFile(outFile, []byte(header+"\n"+rest), 0644))
+
+		out, err := check(t, outFile)
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("stale file fails with a diff", func(t *testing.T) {
+		outFile := filepath.Join(t.TempDir(), "basic_gunion.go")
+		stale := strings.Replace(string(golden), `return "a"`, `return "renamed"`, 1)
+		require.NoError(t, os.WriteFile(outFile, []byte(stale), 0644))
+
+		out, err := check(t, outFile)
+		require.EqualError(t, err, "1 of 1 generated files are out of date")
+		assert.Contains(t, out, "--- "+outFile+"\n+++ "+outFile+"\n")
+		assert.Contains(t, out, "\n-\t\treturn \"renamed\"\n+\t\treturn \"a\"\n")
+
+		// The stale file is left as it was.
+		actual, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		assert.Equal(t, stale, string(actual))
+	})
+
+	t.Run("missing file fails without being written", func(t *testing.T) {
+		outFile := filepath.Join(t.TempDir(), "basic_gunion.go")
+
+		out, err := check(t, outFile)
+		require.EqualError(t, err, "1 of 1 generated files are out of date")
+		assert.Contains(t, out, "--- /dev/null\n+++ "+outFile+"\n")
+		assert.Contains(t, out, "+// Code generated by gunion via `gunion --type myUnion")
+
+		_, err = os.Stat(outFile)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestGenerateCommand(t *testing.T) {
+	origArgs := os.Args
+	t.Cleanup(func() {
+		os.Args = origArgs
+	})
+
+	t.Run("root command", func(t *testing.T) {
+		os.Args = []string{"gunion", "--type", "myUnion"}
+		assert.Equal(t, "gunion --type myUnion", generateCommand(newRootCmd()))
+	})
+
+	t.Run("check subcommand is left out", func(t *testing.T) {
+		os.Args = []string{"gunion", "check", "--type", "myUnion"}
+		root := newRootCmd()
+		check, _, err := root.Find([]string{"check"})
+		require.NoError(t, err)
+		assert.Equal(t, "gunion --type myUnion", generateCommand(check))
+	})
+}
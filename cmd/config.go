@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// applyConfigFile applies the options the nearest config file above dir sets for typeName to cfg: its
+// defaults, then the type's own options. Options whose flags were given explicitly are skipped.
+func applyConfigFile(cfg *config.OutputConfig, dir string, typeName string, flags *pflag.FlagSet) error {
+	f, ok, err := config.FindFile(dir)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	options := slices.Concat(f.Defaults, f.Types[typeName])
+	if err := applyOptions(cfg, options, flags); err != nil {
+		return fmt.Errorf("invalid config file %s for type %s: %w", f.Path, typeName, err)
+	}
+	return nil
+}
+
+// resolvedConfig is the output config of one union as printed by config print. Settings are named after
+// their flags.
+type resolvedConfig struct {
+	Type          string            `yaml:"type"`
+	OutType       string            `yaml:"out-type"`
+	OutFile       string            `yaml:"out-file"`
+	OutPkg        string            `yaml:"out-pkg"`
+	OutPkgPath    string            `yaml:"out-pkg-path,omitempty"`
+	NoGetters     bool              `yaml:"no-getters"`
+	NoSetters     bool              `yaml:"no-setters"`
+	NoMatch       bool              `yaml:"no-match"`
+	MatchE        bool              `yaml:"match-e"`
+	Switch        bool              `yaml:"switch"`
+	MatchOr       bool              `yaml:"match-or"`
+	Visitor       bool              `yaml:"visitor"`
+	NoDefault     bool              `yaml:"no-default"`
+	Kind          bool              `yaml:"kind"`
+	Stable        bool              `yaml:"stable-variants"`
+	Sealed        bool              `yaml:"sealed"`
+	Compact       bool              `yaml:"compact"`
+	Equal         bool              `yaml:"equal"`
+	Format        bool              `yaml:"format"`
+	Slog          bool              `yaml:"slog"`
+	JSON          string            `yaml:"json,omitempty"`
+	SQL           string            `yaml:"sql,omitempty"`
+	Naming        string            `yaml:"naming"`
+	NameTemplates map[string]string `yaml:"name-template,omitempty"`
+}
+
+func newResolvedConfig(typeName string, cfg config.OutputConfig) resolvedConfig {
+	naming := cfg.Naming
+	if naming == "" {
+		naming = config.NamingUnderscore
+	}
+	return resolvedConfig{
+		Type:          typeName,
+		OutType:       cfg.OutType,
+		OutFile:       cfg.OutFile,
+		OutPkg:        cfg.OutPkg,
+		OutPkgPath:    cfg.OutPkgPath,
+		NoGetters:     !cfg.Getters,
+		NoSetters:     !cfg.Setters,
+		NoMatch:       !cfg.Match,
+		MatchE:        cfg.MatchE,
+		Switch:        cfg.Switch,
+		MatchOr:       cfg.MatchOr,
+		Visitor:       cfg.Visitor,
+		NoDefault:     !cfg.Default,
+		Kind:          cfg.Kind,
+		Stable:        cfg.StableVariants,
+		Sealed:        cfg.Sealed,
+		Compact:       cfg.Compact,
+		Equal:         cfg.Equal,
+		Format:        cfg.Format,
+		Slog:          cfg.Slog,
+		JSON:          string(cfg.JSON),
+		SQL:           string(cfg.SQL),
+		Naming:        string(naming),
+		NameTemplates: cfg.NameTemplates,
+	}
+}
+
+// newConfigCmd creates the config subcommand, which groups commands inspecting the configuration.
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspects the configuration of generated unions",
+	}
+	cmd.AddCommand(newConfigPrintCmd())
+	return cmd
+}
+
+// newConfigPrintCmd creates the config print subcommand, which takes the same flags and arguments as the
+// root command.
+func newConfigPrintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "print [packages]",
+		Short: "Prints the resolved settings of every union",
+		Long: `Prints the settings every union would be generated with, as one YAML document per union, without
+generating anything.
+
+Settings are resolved from the flags, the //gunion:union directive of the type, the nearest gunion.yaml
+or .gunion.toml config file above the type's package, and the defaults, in that order of precedence.
+`,
+		Args:         cobra.ArbitraryArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ts, outCfgs, err := loadUnions(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			enc := yaml.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent(2)
+			for i, t := range ts {
+				if err := enc.Encode(newResolvedConfig(t.Name, outCfgs[i])); err != nil {
+					return fmt.Errorf("failed to print config: %w", err)
+				}
+			}
+			return enc.Close()
+		},
+	}
+	setupFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/loader"
+	"github.com/sidkurella/gunion/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFile(t *testing.T) {
+	origGOFILE := os.Getenv("GOFILE")
+	origGOPACKAGE := os.Getenv("GOPACKAGE")
+	t.Cleanup(func() {
+		os.Setenv("GOFILE", origGOFILE)
+		os.Setenv("GOPACKAGE", origGOPACKAGE)
+	})
+	os.Setenv("GOFILE", "")
+	os.Setenv("GOPACKAGE", "")
+
+	// A module with a config file at its root, and a package below it.
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/m\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "gunion.yaml"), []byte(`
+defaults:
+  kind: true
+  json: external
+  naming: camel
+types:
+  shape:
+    out-type: Shape
+    json: adjacent
+`), 0644))
+	pkgDir := filepath.Join(root, "shapes")
+	require.NoError(t, os.Mkdir(pkgDir, 0755))
+	src := filepath.Join(pkgDir, "shapes.go")
+	require.NoError(t, os.WriteFile(src, []byte("package shapes\n"), 0644))
+
+	parse := func(t *testing.T, args ...string) ([]config.OutputConfig, error) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse(append([]string{"--src", src, "--out-pkg", "shapes"}, args...)))
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		return outCfgs, err
+	}
+
+	t.Run("per-type options override defaults", func(t *testing.T) {
+		outCfgs, err := parse(t, "--type", "shape,event")
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 2)
+
+		assert.Equal(t, "Shape", outCfgs[0].OutType)
+		assert.Equal(t, config.JSONAdjacent, outCfgs[0].JSON)
+		assert.True(t, outCfgs[0].Kind)
+		assert.Equal(t, config.NamingCamel, outCfgs[0].Naming)
+
+		assert.Equal(t, "EventUnion", outCfgs[1].OutType)
+		assert.Equal(t, config.JSONExternal, outCfgs[1].JSON)
+		assert.True(t, outCfgs[1].Kind)
+	})
+
+	t.Run("explicit flags override the config file", func(t *testing.T) {
+		outCfgs, err := parse(t, "--type", "shape", "--out-type", "S", "--json", "internal", "--kind=false")
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		assert.Equal(t, "S", outCfgs[0].OutType)
+		assert.Equal(t, config.JSONInternal, outCfgs[0].JSON)
+		assert.False(t, outCfgs[0].Kind)
+		assert.Equal(t, config.NamingCamel, outCfgs[0].Naming)
+	})
+
+	t.Run("out-type from the config file given more than once", func(t *testing.T) {
+		_, err := parse(t, "--type", "shape,other", "--out-type", "Shape,Shape")
+		assert.EqualError(t, err, "out-type Shape given more than once")
+	})
+
+	t.Run("directive options override the config file", func(t *testing.T) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{}))
+
+		d := loader.Directive{
+			Named: types.Named{
+				Name:    "shape",
+				Package: "example.com/m/shapes",
+				Type:    types.Struct{Fields: []types.Field{{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}}}},
+			},
+			File:    src,
+			PkgName: "shapes",
+			Options: []string{"json=internal"},
+		}
+		_, outCfgs, err := directiveConfigs(cmd.Flags(), []loader.Directive{d})
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		assert.Equal(t, "Shape", outCfgs[0].OutType)
+		assert.Equal(t, config.JSONInternal, outCfgs[0].JSON)
+		assert.True(t, outCfgs[0].Kind)
+	})
+
+	t.Run("invalid option", func(t *testing.T) {
+		invalidDir := filepath.Join(root, "invalid")
+		require.NoError(t, os.Mkdir(invalidDir, 0755))
+		configPath := filepath.Join(invalidDir, ".gunion.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("[defaults]\nsideways = true\n"), 0644))
+
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{
+			"--type", "shape", "--src", filepath.Join(invalidDir, "shapes.go"), "--out-pkg", "shapes",
+		}))
+		_, _, err := parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "invalid config file "+configPath+` for type shape: unknown option "sideways=true"`)
+	})
+}
+
+func TestConfigPrint(t *testing.T) {
+	origGOFILE := os.Getenv("GOFILE")
+	origGOPACKAGE := os.Getenv("GOPACKAGE")
+	t.Cleanup(func() {
+		os.Setenv("GOFILE", origGOFILE)
+		os.Setenv("GOPACKAGE", origGOPACKAGE)
+	})
+	os.Setenv("GOFILE", "")
+	os.Setenv("GOPACKAGE", "")
+
+	srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "configfile", "configfile.go"))
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{
+		"config", "print",
+		"--type", "myUnion",
+		"--src", srcAbs,
+		"--out-pkg", "configfile",
+		"--out-file", "/out/configfile_gunion.go",
+		"--kind=false",
+	})
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	// The settings merge the gunion.yaml file next to the source with the flags.
+	assert.Equal(t, `type: myUnion
+out-type: MyUnionUnion
+out-file: /out/configfile_gunion.go
+out-pkg: configfile
+no-getters: false
+no-setters: false
+no-match: false
+match-e: false
+switch: false
+match-or: false
+visitor: false
+no-default: true
+kind: false
+stable-variants: false
+sealed: false
+compact: false
+equal: false
+format: false
+slog: false
+json: external
+naming: camel
+name-template:
+  is: Has{Variant}
+`, out.String())
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/loader"
+	"github.com/sidkurella/gunion/internal/types"
+	"github.com/spf13/pflag"
+)
+
+// directiveBoolOptions are the directive options that toggle a feature. They are named after the
+// corresponding flags, and may be given bare (no-default) or with a value (no-default=false).
+var directiveBoolOptions = map[string]func(cfg *config.OutputConfig, enabled bool){
+	"no-getters":      func(cfg *config.OutputConfig, enabled bool) { cfg.Getters = !enabled },
+	"no-setters":      func(cfg *config.OutputConfig, enabled bool) { cfg.Setters = !enabled },
+	"no-match":        func(cfg *config.OutputConfig, enabled bool) { cfg.Match = !enabled },
+	"match-e":         func(cfg *config.OutputConfig, enabled bool) { cfg.MatchE = enabled },
+	"switch":          func(cfg *config.OutputConfig, enabled bool) { cfg.Switch = enabled },
+	"match-or":        func(cfg *config.OutputConfig, enabled bool) { cfg.MatchOr = enabled },
+	"visitor":         func(cfg *config.OutputConfig, enabled bool) { cfg.Visitor = enabled },
+	"no-default":      func(cfg *config.OutputConfig, enabled bool) { cfg.Default = !enabled },
+	"kind":            func(cfg *config.OutputConfig, enabled bool) { cfg.Kind = enabled },
+	"stable-variants": func(cfg *config.OutputConfig, enabled bool) { cfg.StableVariants = enabled },
+	"sealed":          func(cfg *config.OutputConfig, enabled bool) { cfg.Sealed = enabled },
+	"compact":         func(cfg *config.OutputConfig, enabled bool) { cfg.Compact = enabled },
+	"equal":           func(cfg *config.OutputConfig, enabled bool) { cfg.Equal = enabled },
+	"format":          func(cfg *config.OutputConfig, enabled bool) { cfg.Format = enabled },
+	"slog":            func(cfg *config.OutputConfig, enabled bool) { cfg.Slog = enabled },
+}
+
+// directiveConfigs builds an output config for each discovered type. Each union is written next to the
+// file declaring its type. Directive options override the config file, which overrides the flag defaults,
+// but flags given explicitly win.
+func directiveConfigs(
+	flags *pflag.FlagSet, directives []loader.Directive,
+) ([]types.Named, []config.OutputConfig, error) {
+	if len(directives) == 0 {
+		return nil, nil, fmt.Errorf("no types marked with a //gunion:union comment were found")
+	}
+
+	base, err := parseOutputFlags(flags)
+	if err != nil {
+		return nil, nil, err
+	}
+	split, err := flags.GetBool("split")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse split flag: %w", err)
+	}
+
+	ts := make([]types.Named, 0, len(directives))
+	outCfgs := make([]config.OutputConfig, 0, len(directives))
+	// Out-type names already taken in each package, mapped to the type they were generated from.
+	seenOutTypes := make(map[string]map[string]string)
+	for _, d := range directives {
+		cfg := base
+		cfg.OutType = defaultOutType(d.Named.Name)
+		cfg.OutPkg = d.PkgName
+		if err := applyConfigFile(&cfg, filepath.Dir(d.File), d.Named.Name, flags); err != nil {
+			return nil, nil, err
+		}
+		if err := applyOptions(&cfg, d.Options, flags); err != nil {
+			return nil, nil, fmt.Errorf("invalid directive on type %s: %w", d.Named.Name, err)
+		}
+		if split {
+			cfg.OutFile = splitOutFile(d.File, d.Named.Name)
+		} else {
+			cfg.OutFile = defaultOutFile(d.File)
+		}
+
+		seen, ok := seenOutTypes[d.Named.Package]
+		if !ok {
+			seen = make(map[string]string)
+			seenOutTypes[d.Named.Package] = seen
+		}
+		if other, ok := seen[cfg.OutType]; ok {
+			return nil, nil, fmt.Errorf(
+				"types %s and %s in package %s both generate %s", other, d.Named.Name, d.Named.Package, cfg.OutType,
+			)
+		}
+		seen[cfg.OutType] = d.Named.Name
+
+		ts = append(ts, d.Named)
+		outCfgs = append(outCfgs, cfg)
+	}
+	return ts, outCfgs, nil
+}
+
+// applyOptions applies key or key=value options, from a directive or a config file, to cfg, skipping
+// any option whose flag was given explicitly.
+func applyOptions(cfg *config.OutputConfig, options []string, flags *pflag.FlagSet) error {
+	for _, option := range options {
+		name, value, hasValue := strings.Cut(option, "=")
+		if name == "out" {
+			name = "out-type"
+		}
+		if flags.Changed(name) {
+			continue
+		}
+
+		if apply, ok := directiveBoolOptions[name]; ok {
+			enabled := true
+			if hasValue {
+				var err error
+				enabled, err = strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("option %s: invalid boolean %q", name, value)
+				}
+			}
+			apply(cfg, enabled)
+			continue
+		}
+
+		switch name {
+		case "out-type":
+			if value == "" {
+				return fmt.Errorf("option %s requires a value", name)
+			}
+			cfg.OutType = value
+		case "json":
+			if value == "" {
+				return fmt.Errorf("option %s requires a value", name)
+			}
+			style, err := parseJSONStyle(value)
+			if err != nil {
+				return err
+			}
+			cfg.JSON = style
+		case "sql":
+			if value == "" {
+				return fmt.Errorf("option %s requires a value", name)
+			}
+			encoding, err := parseSQLEncoding(value)
+			if err != nil {
+				return err
+			}
+			cfg.SQL = encoding
+		case "naming":
+			if value == "" {
+				return fmt.Errorf("option %s requires a value", name)
+			}
+			scheme, err := parseNamingScheme(value)
+			if err != nil {
+				return err
+			}
+			cfg.Naming = scheme
+		case "name-template":
+			kind, template, ok := strings.Cut(value, "=")
+			if !ok || kind == "" || template == "" {
+				return fmt.Errorf("option %s requires a value of the form kind=template", name)
+			}
+			// The map is shared with the configs of other directives, so it is copied before adding to it.
+			templates := make(map[string]string, len(cfg.NameTemplates)+1)
+			maps.Copy(templates, cfg.NameTemplates)
+			templates[kind] = template
+			cfg.NameTemplates = templates
+		default:
+			return fmt.Errorf("unknown option %q", option)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/loader"
+	"github.com/sidkurella/gunion/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectiveConfigs(t *testing.T) {
+	named := func(name string) types.Named {
+		return types.Named{
+			Name:    name,
+			Package: "example.com/pkg",
+			Type: types.Struct{
+				Fields: []types.Field{
+					{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
+				},
+			},
+		}
+	}
+	directive := func(name string, options ...string) loader.Directive {
+		return loader.Directive{Named: named(name), File: "/src/pkg/shapes.go", PkgName: "pkg", Options: options}
+	}
+
+	t.Run("defaults come from flags", func(t *testing.T) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{"--no-getters"}))
+
+		ts, outCfgs, err := directiveConfigs(cmd.Flags(), []loader.Directive{directive("shape")})
+		require.NoError(t, err)
+		assert.Equal(t, []types.Named{named("shape")}, ts)
+		assert.Equal(t, []config.OutputConfig{{
+			OutType: "ShapeUnion",
+			OutFile: "/src/pkg/shapes_gunion.go",
+			OutPkg:  "pkg",
+			Getters: false,
+			Setters: true,
+			Match:   true,
+			Default: true,
+		}}, outCfgs)
+	})
+
+	t.Run("options override flag defaults", func(t *testing.T) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{}))
+
+		_, outCfgs, err := directiveConfigs(cmd.Flags(), []loader.Directive{
+			directive(
+				"shape", "out=Shape", "no-default", "no-match=true", "json=adjacent", "sql=json", "stable-variants",
+				"naming=camel", "name-template=is=Has{Variant}", "name-template=get=Find{Variant}",
+			),
+		})
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		assert.Equal(t, "Shape", outCfgs[0].OutType)
+		assert.False(t, outCfgs[0].Default)
+		assert.False(t, outCfgs[0].Match)
+		assert.Equal(t, config.JSONAdjacent, outCfgs[0].JSON)
+		assert.Equal(t, config.SQLJSON, outCfgs[0].SQL)
+		assert.True(t, outCfgs[0].StableVariants)
+		assert.Equal(t, config.NamingCamel, outCfgs[0].Naming)
+		assert.Equal(t, map[string]string{"is": "Has{Variant}", "get": "Find{Variant}"}, outCfgs[0].NameTemplates)
+	})
+
+	t.Run("explicit flags override options", func(t *testing.T) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{"--no-default=false", "--json", "external"}))
+
+		_, outCfgs, err := directiveConfigs(cmd.Flags(), []loader.Directive{
+			directive("shape", "no-default", "json=adjacent"),
+		})
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		assert.True(t, outCfgs[0].Default)
+		assert.Equal(t, config.JSONExternal, outCfgs[0].JSON)
+	})
+
+	t.Run("split writes one file per type", func(t *testing.T) {
+		cmd := newTestCmd()
+		require.NoError(t, cmd.Flags().Parse([]string{"--split"}))
+
+		_, outCfgs, err := directiveConfigs(cmd.Flags(), []loader.Directive{directive("shape"), directive("event")})
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 2)
+		assert.Equal(t, "/src/pkg/shapes_shape_gunion.go", outCfgs[0].OutFile)
+		assert.Equal(t, "/src/pkg/shapes_event_gunion.go", outCfgs[1].OutFile)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		cases := []struct {
+			name       string
+			directives []loader.Directive
+			err        string
+		}{
+			{
+				name: "no directives",
+				err:  "no types marked with a //gunion:union comment were found",
+			},
+			{
+				name:       "unknown option",
+				directives: []loader.Directive{directive("shape", "sideways")},
+				err:        `invalid directive on type shape: unknown option "sideways"`,
+			},
+			{
+				name:       "invalid boolean",
+				directives: []loader.Directive{directive("shape", "no-default=maybe")},
+				err:        `invalid directive on type shape: option no-default: invalid boolean "maybe"`,
+			},
+			{
+				name:       "missing value",
+				directives: []loader.Directive{directive("shape", "out")},
+				err:        "invalid directive on type shape: option out-type requires a value",
+			},
+			{
+				name:       "invalid json style",
+				directives: []loader.Directive{directive("shape", "json=sideways")},
+				err:        `invalid directive on type shape: invalid json style "sideways": must be one of external, adjacent, internal`,
+			},
+			{
+				name:       "name template without a kind",
+				directives: []loader.Directive{directive("shape", "name-template=Has{Variant}")},
+				err:        "invalid directive on type shape: option name-template requires a value of the form kind=template",
+			},
+			{
+				name:       "duplicate out-type",
+				directives: []loader.Directive{directive("shape", "out=Union"), directive("event", "out=Union")},
+				err:        "types shape and event in package example.com/pkg both generate Union",
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				cmd := newTestCmd()
+				require.NoError(t, cmd.Flags().Parse([]string{}))
+
+				_, _, err := directiveConfigs(cmd.Flags(), tc.directives)
+				assert.EqualError(t, err, tc.err)
+			})
+		}
+	})
+}
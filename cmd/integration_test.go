@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/loader"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,6 +94,299 @@ func TestIntegration(t *testing.T) {
 			goldenFile: "torture/gen.go",
 			extraFlags: []string{"--no-default"},
 		},
+		{
+			name:       "basic/json/external",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/json/external/gen.go",
+			extraFlags: []string{"--no-default", "--json", "external"},
+		},
+		{
+			name:       "basic/json/adjacent",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/json/adjacent/gen.go",
+			extraFlags: []string{"--no-default", "--json", "adjacent"},
+		},
+		{
+			name:       "jsoninternal",
+			sourceFile: "jsoninternal/jsoninternal.go",
+			typeName:   "myUnion",
+			outPkg:     "jsoninternal",
+			goldenFile: "jsoninternal/gen.go",
+			extraFlags: []string{"--no-default", "--json", "internal"},
+		},
+		{
+			name:       "pinned",
+			sourceFile: "pinned/pinned.go",
+			typeName:   "myUnion",
+			outPkg:     "pinned",
+			goldenFile: "pinned/gen.go",
+			extraFlags: []string{"--no-default", "--stable-variants"},
+		},
+		{
+			name:       "basic/visitor",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/visitor/gen.go",
+			extraFlags: []string{"--no-default", "--visitor"},
+		},
+		{
+			name:       "basic/matchor",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/matchor/gen.go",
+			extraFlags: []string{"--no-default", "--match-or"},
+		},
+		{
+			name:       "basic/matche",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/matche/gen.go",
+			extraFlags: []string{"--no-default", "--match-e", "--switch"},
+		},
+		{
+			name:       "basic/kind",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/kind/gen.go",
+			extraFlags: []string{"--no-default", "--kind"},
+		},
+		{
+			name:       "crosspkg/out",
+			sourceFile: "crosspkg/crosspkg.go",
+			typeName:   "myUnion",
+			outPkg:     "out",
+			goldenFile: "crosspkg/out/gen.go",
+			extraFlags: []string{
+				"--no-default", "--out-pkg-path", "github.com/sidkurella/gunion/internal/testdata/crosspkg/out",
+			},
+		},
+		{
+			name:       "unit",
+			sourceFile: "unit/unit.go",
+			typeName:   "myUnion",
+			outPkg:     "unit",
+			goldenFile: "unit/gen.go",
+			extraFlags: []string{"--no-default"},
+		},
+		{
+			name:       "unit/json",
+			sourceFile: "unit/unit.go",
+			typeName:   "myUnion",
+			outPkg:     "unit",
+			goldenFile: "unit/json/gen.go",
+			extraFlags: []string{"--no-default", "--json", "external"},
+		},
+		{
+			name:       "unitonly/format",
+			sourceFile: "unitonly/unitonly.go",
+			typeName:   "myUnion",
+			outPkg:     "unitonly",
+			goldenFile: "unitonly/format/gen.go",
+			extraFlags: []string{"--no-default", "--format"},
+		},
+		{
+			name:       "unitonly/sql-columns",
+			sourceFile: "unitonly/unitonly.go",
+			typeName:   "myUnion",
+			outPkg:     "unitonly",
+			goldenFile: "unitonly/sql-columns/gen.go",
+			extraFlags: []string{"--sql", "columns"},
+		},
+		{
+			name:       "unitonly/all",
+			sourceFile: "unitonly/unitonly.go",
+			typeName:   "myUnion",
+			outPkg:     "unitonly",
+			goldenFile: "unitonly/all/gen.go",
+			extraFlags: []string{
+				"--no-default", "--kind", "--equal", "--slog", "--visitor", "--match-or", "--match-e", "--switch",
+				"--json", "adjacent",
+			},
+		},
+		{
+			name:       "record",
+			sourceFile: "record/record.go",
+			typeName:   "myUnion",
+			outPkg:     "record",
+			goldenFile: "record/gen.go",
+			extraFlags: []string{"--no-default"},
+		},
+		{
+			name:       "record/visitor",
+			sourceFile: "record/record.go",
+			typeName:   "myUnion",
+			outPkg:     "record",
+			goldenFile: "record/visitor/gen.go",
+			extraFlags: []string{"--no-default", "--visitor", "--match-or"},
+		},
+		{
+			name:       "record/json",
+			sourceFile: "record/record.go",
+			typeName:   "myUnion",
+			outPkg:     "record",
+			goldenFile: "record/json/gen.go",
+			extraFlags: []string{"--no-default", "--json", "adjacent"},
+		},
+		{
+			name:       "shadow",
+			sourceFile: "shadow/shadow.go",
+			typeName:   "myUnion",
+			outPkg:     "shadow",
+			goldenFile: "shadow/gen.go",
+			extraFlags: []string{},
+		},
+		{
+			name:       "shadow/compact",
+			sourceFile: "shadow/shadow.go",
+			typeName:   "myUnion",
+			outPkg:     "shadow",
+			goldenFile: "shadow/compact/gen.go",
+			extraFlags: []string{"--compact", "--equal", "--format"},
+		},
+		{
+			name:       "basic/sealed",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/sealed/gen.go",
+			extraFlags: []string{"--no-default", "--sealed", "--match-e", "--switch"},
+		},
+		{
+			name:       "generics/sealed",
+			sourceFile: "generics/generics.go",
+			typeName:   "myUnion",
+			outPkg:     "generics",
+			goldenFile: "generics/sealed/gen.go",
+			extraFlags: []string{"--sealed"},
+		},
+		{
+			name:       "basic/compact",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/compact/gen.go",
+			extraFlags: []string{"--no-default", "--compact"},
+		},
+		{
+			name:       "generics/compact",
+			sourceFile: "generics/generics.go",
+			typeName:   "myUnion",
+			outPkg:     "generics",
+			goldenFile: "generics/compact/gen.go",
+			extraFlags: []string{"--compact"},
+		},
+		{
+			name:       "torture/equal",
+			sourceFile: "torture/torture.go",
+			typeName:   "myUnion",
+			outPkg:     "torture",
+			goldenFile: "torture/equal/gen.go",
+			extraFlags: []string{"--no-default", "--equal"},
+		},
+		{
+			name:       "generics/equal",
+			sourceFile: "generics/generics.go",
+			typeName:   "myUnion",
+			outPkg:     "generics",
+			goldenFile: "generics/equal/gen.go",
+			extraFlags: []string{"--equal"},
+		},
+		{
+			name:       "basic/format",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/format/gen.go",
+			extraFlags: []string{"--no-default", "--format"},
+		},
+		{
+			name:       "record/format",
+			sourceFile: "record/record.go",
+			typeName:   "myUnion",
+			outPkg:     "record",
+			goldenFile: "record/format/gen.go",
+			extraFlags: []string{"--no-default", "--format"},
+		},
+		{
+			name:       "basic/slog",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/slog/gen.go",
+			extraFlags: []string{"--no-default", "--slog"},
+		},
+		{
+			name:       "basic/sql-json",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/sql-json/gen.go",
+			extraFlags: []string{"--no-default", "--json", "adjacent", "--sql", "json"},
+		},
+		{
+			name:       "basic/sql-columns",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/sql-columns/gen.go",
+			extraFlags: []string{"--no-default", "--sql", "columns"},
+		},
+		{
+			name:       "record/sql-columns",
+			sourceFile: "record/record.go",
+			typeName:   "myUnion",
+			outPkg:     "record",
+			goldenFile: "record/sql-columns/gen.go",
+			extraFlags: []string{"--sql", "columns"},
+		},
+		{
+			name:       "tagged",
+			sourceFile: "tagged/tagged.go",
+			typeName:   "myUnion",
+			outPkg:     "tagged",
+			goldenFile: "tagged/gen.go",
+			extraFlags: []string{},
+		},
+		{
+			name:       "tagged/all",
+			sourceFile: "tagged/tagged.go",
+			typeName:   "myUnion",
+			outPkg:     "tagged",
+			goldenFile: "tagged/all/gen.go",
+			extraFlags: []string{"--kind", "--format", "--slog", "--json", "external", "--sql", "columns"},
+		},
+		{
+			name:       "basic/camel",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/camel/gen.go",
+			extraFlags: []string{"--no-default", "--naming", "camel", "--match-e", "--switch", "--match-or", "--visitor", "--kind", "--format"},
+		},
+		{
+			name:       "basic/template",
+			sourceFile: "basic/basic.go",
+			typeName:   "myUnion",
+			outPkg:     "basic",
+			goldenFile: "basic/template/gen.go",
+			extraFlags: []string{"--no-default", "--name-template", "is=Has{Variant},constructor=Make{Type}{Variant}"},
+		},
+		{
+			name:       "configfile",
+			sourceFile: "configfile/configfile.go",
+			typeName:   "myUnion",
+			outPkg:     "configfile",
+			goldenFile: "configfile/gen.go",
+			// Options come from the gunion.yaml file next to the source file.
+		},
 	}
 
 	// Save and restore global state.
@@ -159,6 +454,99 @@ func TestIntegration(t *testing.T) {
 	}
 }
 
+func TestIntegrationMultipleTypes(t *testing.T) {
+	// Save and restore global state.
+	origGOFILE := os.Getenv("GOFILE")
+	origGOPACKAGE := os.Getenv("GOPACKAGE")
+	origArgs := os.Args
+	t.Cleanup(func() {
+		os.Setenv("GOFILE", origGOFILE)
+		os.Setenv("GOPACKAGE", origGOPACKAGE)
+		os.Args = origArgs
+	})
+
+	os.Setenv("GOFILE", "")
+	os.Setenv("GOPACKAGE", "")
+
+	srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "multi", "multi.go"))
+	require.NoError(t, err)
+	outFile := filepath.Join(t.TempDir(), "multi_gunion.go")
+
+	os.Args = []string{"gunion", "--type", "myUnion,otherUnion", "--src", "source.go", "--no-default"}
+
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{
+		"--type", "myUnion",
+		"--type", "otherUnion",
+		"--src", srcAbs,
+		"--out-type", "MyUnionUnion,OtherUnionUnion",
+		"--out-pkg", "multi",
+		"--out-file", outFile,
+		"--no-default",
+	})
+	err = cmd.Execute()
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	expected, err := os.ReadFile(filepath.Join("..", "internal", "testdata", "multi", "gen.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
+
+// redirectLoader discovers types with a real loader, but moves their declaring files into dir,
+// so that generated files are written there instead of next to the test data.
+type redirectLoader struct {
+	*loader.Loader
+	dir string
+}
+
+func (l redirectLoader) Discover() ([]loader.Directive, error) {
+	directives, err := l.Loader.Discover()
+	for i := range directives {
+		directives[i].File = filepath.Join(l.dir, filepath.Base(directives[i].File))
+	}
+	return directives, err
+}
+
+func TestIntegrationDiscover(t *testing.T) {
+	// Save and restore global state.
+	origLoaderFactory := LoaderFactory
+	origGOFILE := os.Getenv("GOFILE")
+	origGOPACKAGE := os.Getenv("GOPACKAGE")
+	origArgs := os.Args
+	t.Cleanup(func() {
+		LoaderFactory = origLoaderFactory
+		os.Setenv("GOFILE", origGOFILE)
+		os.Setenv("GOPACKAGE", origGOPACKAGE)
+		os.Args = origArgs
+	})
+
+	os.Setenv("GOFILE", "")
+	os.Setenv("GOPACKAGE", "")
+
+	tmpDir := t.TempDir()
+	LoaderFactory = func(cfg config.InputConfig) Loader {
+		return redirectLoader{Loader: loader.NewLoader(cfg), dir: tmpDir}
+	}
+
+	srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "directives", "directives.go"))
+	require.NoError(t, err)
+
+	os.Args = []string{"gunion", "--src", "source.go"}
+
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{"--src", srcAbs})
+	err = cmd.Execute()
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(filepath.Join(tmpDir, "directives_gunion.go"))
+	require.NoError(t, err)
+	expected, err := os.ReadFile(filepath.Join("..", "internal", "testdata", "directives", "gen.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
+
 func TestIntegrationErrors(t *testing.T) {
 	// Save and restore global state.
 	origLoaderFactory := LoaderFactory
@@ -233,6 +621,26 @@ func TestIntegrationErrors(t *testing.T) {
 		require.Contains(t, err.Error(), "expected a struct type")
 	})
 
+	t.Run("stale output file in the source package", func(t *testing.T) {
+		outFile := filepath.Join(tmpDir, "stale_gunion.go")
+		srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "stale", "stale.go"))
+		require.NoError(t, err)
+
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{
+			"--type", "myUnion",
+			"--src", srcAbs,
+			"--out-type", "MyUnionUnion",
+			"--out-pkg", "stale",
+			"--out-file", outFile,
+		})
+		require.NoError(t, cmd.Execute())
+
+		actual, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		require.Contains(t, string(actual), "func (u *MyUnionUnion) Is_c() bool")
+	})
+
 	t.Run("source file with compile errors", func(t *testing.T) {
 		outFile := filepath.Join(tmpDir, "compileerror_gunion.go")
 		srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "compileerror", "compileerror.go"))
@@ -251,15 +659,51 @@ func TestIntegrationErrors(t *testing.T) {
 		require.Contains(t, err.Error(), "had errors")
 	})
 
-	t.Run("missing required type flag", func(t *testing.T) {
+	t.Run("out-pkg of another package without out-pkg-path", func(t *testing.T) {
+		outFile := filepath.Join(tmpDir, "crosspkg_gunion.go")
+		srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "crosspkg", "crosspkg.go"))
+		require.NoError(t, err)
+
 		cmd := newRootCmd()
 		cmd.SetArgs([]string{
-			"--src", "test.go",
-			"--out-pkg", "testpkg",
+			"--type", "myUnion",
+			"--src", srcAbs,
+			"--out-pkg", "out",
+			"--out-file", outFile,
 		})
-		err := cmd.Execute()
+		err = cmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "out-pkg out is not the package of")
+		require.Contains(t, err.Error(), "set out-pkg-path to generate into another package")
+	})
+
+	t.Run("unexported type used from another package", func(t *testing.T) {
+		outFile := filepath.Join(tmpDir, "crosspkgbad_gunion.go")
+		srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "crosspkg", "crosspkg.go"))
+		require.NoError(t, err)
+
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{
+			"--type", "badUnion",
+			"--src", srcAbs,
+			"--out-pkg", "out",
+			"--out-pkg-path", "example.com/out",
+			"--out-file", outFile,
+		})
+		err = cmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "field b uses unexported type")
+	})
+
+	t.Run("no type and no marked types", func(t *testing.T) {
+		srcAbs, err := filepath.Abs(filepath.Join("..", "internal", "testdata", "basic", "basic.go"))
+		require.NoError(t, err)
+
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{"--src", srcAbs})
+		err = cmd.Execute()
 		require.Error(t, err)
-		require.Contains(t, err.Error(), "required flag")
+		require.Contains(t, err.Error(), "no types marked with a //gunion:union comment were found")
 	})
 
 	t.Run("missing src and GOFILE", func(t *testing.T) {
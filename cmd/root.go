@@ -23,6 +23,8 @@ package cmd
 
 import (
 	"fmt"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
 	"strings"
@@ -36,49 +38,52 @@ import (
 )
 
 type Loader interface {
-	Load() (types.Named, error)
+	Load() ([]types.Named, error)
+	Discover() ([]loader.Directive, error)
 }
 
 type Generator interface {
-	Generate(types.Named) error
+	Generate(...types.Named) error
+	Render(...types.Named) ([]codegen.File, error)
 }
 
 // Expose factories for loader and generator to allow for overriding in tests.
 var LoaderFactory func(config.InputConfig) Loader = func(cfg config.InputConfig) Loader {
 	return loader.NewLoader(cfg)
 }
-var GeneratorFactory func(config.OutputConfig) Generator = func(cfg config.OutputConfig) Generator {
-	return codegen.NewCodeGenerator(cfg)
+var GeneratorFactory func(...config.OutputConfig) Generator = func(cfgs ...config.OutputConfig) Generator {
+	return codegen.NewCodeGenerator(cfgs...)
 }
 
 // newRootCmd creates a fresh root command with all flags configured.
 // This is used by Execute() for production and by tests for flag isolation.
 func newRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "gunion",
+		Use:   "gunion [packages]",
 		Short: "Generates tagged unions based on a struct definition",
 		Long: `Generates a tagged union based on a struct definition.
 
 The resultant union provides a variant field indicating which of the fields is valid.
 The first field of the union should be your default type.
+
+Several unions can be generated from the same package at once by passing a
+comma-separated list (or repeating --type), with matching --out-type names.
+
+Without --type, every struct marked with a //gunion:union comment is generated,
+either in the source file's package or in the given packages (e.g. ./...).
+Options follow the marker and mirror the flags:
+
+	//gunion:union out=Shape no-default json=adjacent
 `,
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			flags := cmd.Flags()
-
-			inCfg, outCfg, err := parseFlags(flags)
+			ts, outCfgs, err := loadUnions(cmd, args)
 			if err != nil {
 				return err
 			}
-			outCfg.Command = strings.Join(os.Args, " ")
 
-			ldr := LoaderFactory(inCfg)
-			t, err := ldr.Load()
-			if err != nil {
-				return fmt.Errorf("failed to load type: %w", err)
-			}
-
-			gen := GeneratorFactory(outCfg)
-			err = gen.Generate(t)
+			gen := GeneratorFactory(outCfgs...)
+			err = gen.Generate(ts...)
 			if err != nil {
 				return fmt.Errorf("failed to generate code: %w", err)
 			}
@@ -87,101 +92,425 @@ The first field of the union should be your default type.
 		},
 	}
 	setupFlags(cmd)
+	cmd.AddCommand(newCheckCmd())
+	cmd.AddCommand(newConfigCmd())
 	return cmd
 }
 
+// loadUnions parses the flags, loads or discovers the requested types, and returns them
+// along with the output config for each.
+func loadUnions(cmd *cobra.Command, args []string) ([]types.Named, []config.OutputConfig, error) {
+	flags := cmd.Flags()
+
+	inCfg, outCfgs, err := parseFlags(flags, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ldr := LoaderFactory(inCfg)
+	var ts []types.Named
+	if len(inCfg.Types) > 0 {
+		ts, err = ldr.Load()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load type: %w", err)
+		}
+	} else {
+		directives, err := ldr.Discover()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to discover types: %w", err)
+		}
+		ts, outCfgs, err = directiveConfigs(flags, directives)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	command := generateCommand(cmd)
+	for i := range outCfgs {
+		outCfgs[i].Command = command
+	}
+	return ts, outCfgs, nil
+}
+
+// generateCommand returns the command line that generates the same files as this invocation,
+// which is recorded in the generated header. Subcommands such as check are left out.
+func generateCommand(cmd *cobra.Command) string {
+	args := os.Args
+	if cmd.HasParent() && len(args) > 1 && args[1] == cmd.Name() {
+		args = append([]string{args[0]}, args[2:]...)
+	}
+	return strings.Join(args, " ")
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = newRootCmd()
 
-func parseFlags(flags *pflag.FlagSet) (config.InputConfig, config.OutputConfig, error) {
-	inType, err := flags.GetString("type")
+// parseFlags builds the input config and one output config per requested type.
+// If no types are given, types are discovered from directives after loading, so no output configs are returned.
+func parseFlags(flags *pflag.FlagSet, args []string) (config.InputConfig, []config.OutputConfig, error) {
+	inTypes, err := flags.GetStringSlice("type")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse type flag: %w", err)
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse type flag: %w", err)
 	}
-	if inType == "" {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("received empty input type")
+	if len(inTypes) == 0 {
+		inCfg, err := parseDiscoveryFlags(flags, args)
+		return inCfg, nil, err
+	}
+	if len(args) > 0 {
+		return config.InputConfig{}, nil, fmt.Errorf("package patterns cannot be used with type")
+	}
+	seenTypes := make(map[string]bool, len(inTypes))
+	for _, inType := range inTypes {
+		if inType == "" {
+			return config.InputConfig{}, nil, fmt.Errorf("received empty input type")
+		}
+		if seenTypes[inType] {
+			return config.InputConfig{}, nil, fmt.Errorf("input type %s given more than once", inType)
+		}
+		seenTypes[inType] = true
 	}
 
-	outType, err := flags.GetString("out-type")
+	outTypes, err := flags.GetStringSlice("out-type")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse out-type flag: %w", err)
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse out-type flag: %w", err)
+	}
+	if len(outTypes) == 0 {
+		outTypes = make([]string, len(inTypes))
 	}
-	if outType == "" {
-		outType = strings.ToUpper(inType[0:1]) + inType[1:] + "Union"
+	if len(outTypes) != len(inTypes) {
+		return config.InputConfig{}, nil,
+			fmt.Errorf("got %d out-types for %d types: give one out-type per type, or none", len(outTypes), len(inTypes))
+	}
+	for i, inType := range inTypes {
+		if outTypes[i] == "" {
+			outTypes[i] = defaultOutType(inType)
+		}
 	}
 
-	src, err := flags.GetString("src")
+	src, err := parseSrc(flags)
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse src flag: %w", err)
+		return config.InputConfig{}, nil, err
 	}
 	if src == "" {
-		goFile := os.Getenv("GOFILE")
-		if goFile == "" {
-			return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("one of src or GOFILE must be set")
-		}
-		src = goFile
+		return config.InputConfig{}, nil, fmt.Errorf("one of src or GOFILE must be set")
 	}
 
 	outFile, err := flags.GetString("out-file")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse out-file flag: %w", err)
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse out-file flag: %w", err)
+	}
+
+	split, err := flags.GetBool("split")
+	if err != nil {
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse split flag: %w", err)
+	}
+	if split && outFile != "" {
+		return config.InputConfig{}, nil, fmt.Errorf("out-file cannot be used with split")
 	}
-	if outFile == "" {
-		ext := filepath.Ext(src)
-		basename := strings.TrimSuffix(src, ext)
-		outFile = basename + "_gunion" + ext
+
+	// Output file for each type. Without split, every union goes to the same file.
+	outFiles := make([]string, len(inTypes))
+	for i, inType := range inTypes {
+		switch {
+		case split:
+			outFiles[i] = splitOutFile(src, inType)
+		case outFile != "":
+			outFiles[i] = outFile
+		default:
+			outFiles[i] = defaultOutFile(src)
+		}
 	}
 
 	outPkg, err := flags.GetString("out-pkg")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse out-pkg flag: %w", err)
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse out-pkg flag: %w", err)
 	}
 	if outPkg == "" {
 		goPkg := os.Getenv("GOPACKAGE")
 		if goPkg == "" {
-			return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("one of out-pkg or GOPACKAGE must be set")
+			return config.InputConfig{}, nil, fmt.Errorf("one of out-pkg or GOPACKAGE must be set")
 		}
 		outPkg = goPkg
 	}
+	outPkgPath, err := flags.GetString("out-pkg-path")
+	if err != nil {
+		return config.InputConfig{}, nil, fmt.Errorf("failed to parse out-pkg-path flag: %w", err)
+	}
+
+	base, err := parseOutputFlags(flags)
+	if err != nil {
+		return config.InputConfig{}, nil, err
+	}
+
+	path, err := filepath.Abs(src)
+	if err != nil {
+		return config.InputConfig{}, nil,
+			fmt.Errorf("failed to convert src filepath %s to absolute: %w", src, err)
+	}
+	if outPkgPath == "" {
+		if err := checkOutPkg(path, outPkg); err != nil {
+			return config.InputConfig{}, nil, err
+		}
+	}
+	outCfgs := make([]config.OutputConfig, len(inTypes))
+	seenOutTypes := make(map[string]bool, len(outTypes))
+	for i, inType := range inTypes {
+		outCfgs[i] = base
+		outCfgs[i].OutType = outTypes[i]
+		// The config file may set the out-type, so it is only checked for duplicates afterwards.
+		if err := applyConfigFile(&outCfgs[i], filepath.Dir(path), inType, flags); err != nil {
+			return config.InputConfig{}, nil, err
+		}
+		if seenOutTypes[outCfgs[i].OutType] {
+			return config.InputConfig{}, nil, fmt.Errorf("out-type %s given more than once", outCfgs[i].OutType)
+		}
+		seenOutTypes[outCfgs[i].OutType] = true
+		outCfgs[i].OutFile = outFiles[i]
+		outCfgs[i].OutPkg = outPkg
+		outCfgs[i].OutPkgPath = outPkgPath
+	}
+	return config.InputConfig{
+		Source: path,
+		Types:  inTypes,
+	}, outCfgs, nil
+}
+
+// parseDiscoveryFlags builds the input config used to discover types from directives.
+// The output file, package and type names come from each type's declaration, so they can't be given as flags.
+func parseDiscoveryFlags(flags *pflag.FlagSet, args []string) (config.InputConfig, error) {
+	for _, name := range []string{"out-type", "out-file", "out-pkg", "out-pkg-path"} {
+		if flags.Changed(name) {
+			return config.InputConfig{}, fmt.Errorf("%s cannot be used without type", name)
+		}
+	}
+	if len(args) > 0 {
+		if flags.Changed("src") {
+			return config.InputConfig{}, fmt.Errorf("src cannot be used with package patterns")
+		}
+		return config.InputConfig{Patterns: args}, nil
+	}
+
+	src, err := parseSrc(flags)
+	if err != nil {
+		return config.InputConfig{}, err
+	}
+	if src == "" {
+		// Search the current directory's package.
+		return config.InputConfig{}, nil
+	}
+	path, err := filepath.Abs(src)
+	if err != nil {
+		return config.InputConfig{}, fmt.Errorf("failed to convert src filepath %s to absolute: %w", src, err)
+	}
+	return config.InputConfig{Source: path}, nil
+}
+
+// checkOutPkg checks that the output package is the package of the source file, as is assumed when
+// out-pkg-path isn't given. A source file that can't be parsed is left for the loader to report.
+func checkOutPkg(src string, outPkg string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), src, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return nil
+	}
+	if srcPkg := file.Name.Name; srcPkg != outPkg {
+		return fmt.Errorf(
+			"out-pkg %s is not the package of %s (%s): set out-pkg-path to generate into another package",
+			outPkg, src, srcPkg,
+		)
+	}
+	return nil
+}
+
+// parseSrc returns the source file from the src flag, falling back to GOFILE. It may be empty.
+func parseSrc(flags *pflag.FlagSet) (string, error) {
+	src, err := flags.GetString("src")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse src flag: %w", err)
+	}
+	if src == "" {
+		src = os.Getenv("GOFILE")
+	}
+	return src, nil
+}
 
+// parseOutputFlags builds an output config from the flags that apply to every union.
+// The type, file and package are left for the caller to fill in.
+func parseOutputFlags(flags *pflag.FlagSet) (config.OutputConfig, error) {
 	noGetters, err := flags.GetBool("no-getters")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse no-getters flag: %w", err)
+		return config.OutputConfig{}, fmt.Errorf("failed to parse no-getters flag: %w", err)
 	}
 
 	noSetters, err := flags.GetBool("no-setters")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse no-setters flag: %w", err)
+		return config.OutputConfig{}, fmt.Errorf("failed to parse no-setters flag: %w", err)
 	}
 
 	noMatch, err := flags.GetBool("no-match")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse no-match flag: %w", err)
+		return config.OutputConfig{}, fmt.Errorf("failed to parse no-match flag: %w", err)
+	}
+
+	matchE, err := flags.GetBool("match-e")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse match-e flag: %w", err)
+	}
+
+	switchFunc, err := flags.GetBool("switch")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse switch flag: %w", err)
+	}
+
+	matchOr, err := flags.GetBool("match-or")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse match-or flag: %w", err)
+	}
+
+	visitor, err := flags.GetBool("visitor")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse visitor flag: %w", err)
 	}
 
 	noDefault, err := flags.GetBool("no-default")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{}, fmt.Errorf("failed to parse no-default flag: %w", err)
+		return config.OutputConfig{}, fmt.Errorf("failed to parse no-default flag: %w", err)
 	}
 
-	path, err := filepath.Abs(src)
+	kind, err := flags.GetBool("kind")
 	if err != nil {
-		return config.InputConfig{}, config.OutputConfig{},
-			fmt.Errorf("failed to convert src filepath %s to absolute: %w", src, err)
+		return config.OutputConfig{}, fmt.Errorf("failed to parse kind flag: %w", err)
+	}
+
+	jsonFlag, err := flags.GetString("json")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse json flag: %w", err)
+	}
+	jsonStyle, err := parseJSONStyle(jsonFlag)
+	if err != nil {
+		return config.OutputConfig{}, err
+	}
+
+	stableVariants, err := flags.GetBool("stable-variants")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse stable-variants flag: %w", err)
+	}
+
+	sealed, err := flags.GetBool("sealed")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse sealed flag: %w", err)
+	}
+
+	compact, err := flags.GetBool("compact")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse compact flag: %w", err)
+	}
+
+	equal, err := flags.GetBool("equal")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse equal flag: %w", err)
+	}
+
+	format, err := flags.GetBool("format")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse format flag: %w", err)
+	}
+
+	slog, err := flags.GetBool("slog")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse slog flag: %w", err)
+	}
+
+	sqlFlag, err := flags.GetString("sql")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse sql flag: %w", err)
+	}
+	sqlEncoding, err := parseSQLEncoding(sqlFlag)
+	if err != nil {
+		return config.OutputConfig{}, err
+	}
+
+	namingFlag, err := flags.GetString("naming")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse naming flag: %w", err)
+	}
+	naming, err := parseNamingScheme(namingFlag)
+	if err != nil {
+		return config.OutputConfig{}, err
+	}
+
+	nameTemplates, err := flags.GetStringToString("name-template")
+	if err != nil {
+		return config.OutputConfig{}, fmt.Errorf("failed to parse name-template flag: %w", err)
+	}
+	if len(nameTemplates) == 0 {
+		nameTemplates = nil
+	}
+
+	return config.OutputConfig{
+		Getters:        !noGetters,
+		Setters:        !noSetters,
+		Match:          !noMatch,
+		MatchE:         matchE,
+		Switch:         switchFunc,
+		MatchOr:        matchOr,
+		Visitor:        visitor,
+		Default:        !noDefault,
+		Kind:           kind,
+		JSON:           jsonStyle,
+		StableVariants: stableVariants,
+		Sealed:         sealed,
+		Compact:        compact,
+		Equal:          equal,
+		Format:         format,
+		Slog:           slog,
+		SQL:            sqlEncoding,
+		Naming:         naming,
+		NameTemplates:  nameTemplates,
+	}, nil
+}
+
+func parseJSONStyle(s string) (config.JSONStyle, error) {
+	switch style := config.JSONStyle(s); style {
+	case config.JSONNone, config.JSONExternal, config.JSONAdjacent, config.JSONInternal:
+		return style, nil
+	default:
+		return "", fmt.Errorf("invalid json style %q: must be one of external, adjacent, internal", s)
+	}
+}
+
+func parseSQLEncoding(s string) (config.SQLEncoding, error) {
+	switch encoding := config.SQLEncoding(s); encoding {
+	case config.SQLNone, config.SQLJSON, config.SQLColumns:
+		return encoding, nil
+	default:
+		return "", fmt.Errorf("invalid sql encoding %q: must be one of json, columns", s)
+	}
+}
+
+func parseNamingScheme(s string) (config.NamingScheme, error) {
+	switch scheme := config.NamingScheme(s); scheme {
+	case "", config.NamingUnderscore, config.NamingCamel:
+		return scheme, nil
+	default:
+		return "", fmt.Errorf("invalid naming scheme %q: must be one of underscore, camel", s)
 	}
-	return config.InputConfig{
-			Source: path,
-			Type:   inType,
-		},
-		config.OutputConfig{
-			OutType: outType,
-			OutFile: outFile,
-			OutPkg:  outPkg,
-			Getters: !noGetters,
-			Setters: !noSetters,
-			Match:   !noMatch,
-			Default: !noDefault,
-		}, nil
+}
+
+// defaultOutType capitalizes the input type name and suffixes it with Union.
+func defaultOutType(inType string) string {
+	return strings.ToUpper(inType[0:1]) + inType[1:] + "Union"
+}
+
+// defaultOutFile returns src_gunion.go for src.go.
+func defaultOutFile(src string) string {
+	ext := filepath.Ext(src)
+	return strings.TrimSuffix(src, ext) + "_gunion" + ext
+}
+
+// splitOutFile returns src_<type>_gunion.go for src.go.
+func splitOutFile(src string, inType string) string {
+	ext := filepath.Ext(src)
+	return strings.TrimSuffix(src, ext) + "_" + inType + "_gunion" + ext
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -195,22 +524,82 @@ func Execute() {
 
 // setupFlags configures all flags on the given command.
 func setupFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("type", "t", "", "Type to generate the union from.")
-	_ = cmd.MarkFlagRequired("type")
-	cmd.Flags().String(
-		"out-type", "",
-		"Output type name. If not specified, capitalizes the input type name and suffixes with Union.",
+	cmd.Flags().StringSliceP(
+		"type", "t", nil,
+		"Types to generate unions from. Accepts a comma-separated list. If not specified, generates every type "+
+			"marked with a //gunion:union comment.",
+	)
+	cmd.Flags().StringSlice(
+		"out-type", nil,
+		"Output type names, one per type. If not specified, capitalizes the input type name and suffixes with Union.",
 	)
 	cmd.Flags().String(
 		"src", "",
 		"File to read from. If not present, populates with value from GOFILE environment variable.",
 	)
 	cmd.Flags().StringP("out-file", "o", "", "Output file name. If not specified, uses src_gunion.go")
+	cmd.Flags().Bool("split", false, "Write each union to its own file, src_<type>_gunion.go, instead of one file.")
 	cmd.Flags().String("out-pkg", "", "Output package name. If not specified, uses current package.")
+	cmd.Flags().String(
+		"out-pkg-path", "",
+		"Import path of the output package, if it isn't the source package. The union then only uses exported "+
+			"identifiers of the source package.",
+	)
 	cmd.Flags().Bool("no-getters", false, "Omit getters for union members.")
 	cmd.Flags().Bool("no-setters", false, "Omit setters for union members.")
 	cmd.Flags().Bool("no-match", false, "Omit match function for union members.")
+	cmd.Flags().Bool("match-e", false, "Generate a MatchE function whose arms return a result and an error.")
+	cmd.Flags().Bool("switch", false, "Generate a Switch function whose arms return nothing.")
+	cmd.Flags().Bool(
+		"match-or", false, "Generate a MatchOr function that takes arms for some variants and a fallback for the rest.",
+	)
+	cmd.Flags().Bool("visitor", false, "Generate a visitor interface with one method per variant, and an Accept function.")
 	cmd.Flags().Bool(
 		"no-default", false, "Don't assume first field is the default. Instead, default value will be invalid.",
 	)
+	cmd.Flags().Bool(
+		"kind", false, "Generate an exported <OutType>Kind type, a Kind method and a Variants_<OutType> function.",
+	)
+	cmd.Flags().String(
+		"json", "",
+		"Generate MarshalJSON/UnmarshalJSON using the given variant tagging style: external, adjacent or internal.",
+	)
+	cmd.Flags().Bool(
+		"stable-variants", false,
+		"Fail if a variant's number differs from the existing output file, or a removed variant's number is reused.",
+	)
+	cmd.Flags().Bool(
+		"sealed", false,
+		"Generate an interface with one type per variant instead of a union struct. Only Match, MatchE and Switch "+
+			"are supported.",
+	)
+	cmd.Flags().Bool(
+		"compact", false,
+		"Store the active payload in a single any field instead of embedding the whole source struct.",
+	)
+	cmd.Flags().Bool(
+		"equal", false,
+		"Generate an Equal method comparing the variants and active payloads of two unions.",
+	)
+	cmd.Flags().Bool(
+		"format", false,
+		"Generate String, GoString and Format methods that only print the active variant.",
+	)
+	cmd.Flags().Bool(
+		"slog", false,
+		"Generate a LogValue method that logs only the active variant with log/slog.",
+	)
+	cmd.Flags().String(
+		"sql", "",
+		"Generate database/sql Scan/Value methods using the given encoding: json or columns.",
+	)
+	cmd.Flags().String(
+		"naming", "",
+		"Naming scheme of generated identifiers: underscore (Is_circle, the default) or camel (IsCircle).",
+	)
+	cmd.Flags().StringToString(
+		"name-template", nil,
+		"Templates overriding the naming scheme for some identifiers, such as is=Has{Variant}. "+
+			"Templates may use {Type}, {variant} and {Variant}.",
+	)
 }
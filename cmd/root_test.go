@@ -6,7 +6,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/sidkurella/gunion/internal/codegen"
 	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/loader"
 	"github.com/sidkurella/gunion/internal/types"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -15,27 +17,39 @@ import (
 
 // mockLoader implements Loader for testing.
 type mockLoader struct {
-	result types.Named
-	err    error
+	result     []types.Named
+	directives []loader.Directive
+	err        error
 }
 
-func (m *mockLoader) Load() (types.Named, error) {
+func (m *mockLoader) Load() ([]types.Named, error) {
 	return m.result, m.err
 }
 
+func (m *mockLoader) Discover() ([]loader.Directive, error) {
+	return m.directives, m.err
+}
+
 // mockGenerator implements Generator for testing.
 type mockGenerator struct {
-	received types.Named
+	received []types.Named
 	called   bool
+	files    []codegen.File
 	err      error
 }
 
-func (m *mockGenerator) Generate(t types.Named) error {
+func (m *mockGenerator) Generate(ts ...types.Named) error {
 	m.called = true
-	m.received = t
+	m.received = ts
 	return m.err
 }
 
+func (m *mockGenerator) Render(ts ...types.Named) ([]codegen.File, error) {
+	m.called = true
+	m.received = ts
+	return m.files, m.err
+}
+
 // newTestCmd creates a fresh command with all flags configured.
 // This ensures each test has isolated flag state.
 func newTestCmd() *cobra.Command {
@@ -53,7 +67,7 @@ func TestParseFlags(t *testing.T) {
 		os.Setenv("GOPACKAGE", origGOPACKAGE)
 	})
 
-	t.Run("required type flag missing", func(t *testing.T) {
+	t.Run("no type discovers from the source package", func(t *testing.T) {
 		os.Setenv("GOFILE", "test.go")
 		os.Setenv("GOPACKAGE", "testpkg")
 
@@ -61,9 +75,93 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{})
 		require.NoError(t, err)
 
-		_, _, err = parseFlags(cmd.Flags())
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "empty input type")
+		inCfg, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		assert.Nil(t, outCfgs)
+
+		absPath, _ := filepath.Abs("test.go")
+		assert.Equal(t, config.InputConfig{Source: absPath}, inCfg)
+	})
+
+	t.Run("no type and no src discovers from the current directory", func(t *testing.T) {
+		os.Setenv("GOFILE", "")
+		os.Setenv("GOPACKAGE", "")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{})
+		require.NoError(t, err)
+
+		inCfg, _, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, config.InputConfig{}, inCfg)
+	})
+
+	t.Run("package patterns are passed to the loader", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"./..."})
+		require.NoError(t, err)
+
+		inCfg, _, err := parseFlags(cmd.Flags(), cmd.Flags().Args())
+		require.NoError(t, err)
+		assert.Equal(t, config.InputConfig{Patterns: []string{"./..."}}, inCfg)
+	})
+
+	t.Run("package patterns with type errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "./..."})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), cmd.Flags().Args())
+		assert.EqualError(t, err, "package patterns cannot be used with type")
+	})
+
+	t.Run("package patterns with src errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "")
+		os.Setenv("GOPACKAGE", "")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--src", "test.go", "./..."})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), cmd.Flags().Args())
+		assert.EqualError(t, err, "src cannot be used with package patterns")
+	})
+
+	t.Run("per-type flags without type error", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		for _, args := range [][]string{
+			{"--out-type", "Shape"},
+			{"--out-file", "out.go"},
+			{"--out-pkg", "pkg"},
+			{"--out-pkg-path", "example.com/pkg"},
+		} {
+			cmd := newTestCmd()
+			err := cmd.Flags().Parse(args)
+			require.NoError(t, err)
+
+			_, _, err = parseFlags(cmd.Flags(), nil)
+			assert.EqualError(t, err, args[0][2:]+" cannot be used without type")
+		}
+	})
+
+	t.Run("empty type errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "received empty input type")
 	})
 
 	t.Run("required src falls back to GOFILE", func(t *testing.T) {
@@ -74,12 +172,14 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion"})
 		require.NoError(t, err)
 
-		inCfg, outCfg, err := parseFlags(cmd.Flags())
+		inCfg, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 
 		absPath, _ := filepath.Abs("myfile.go")
 		assert.Equal(t, absPath, inCfg.Source)
-		assert.Equal(t, "myUnion", inCfg.Type)
+		assert.Equal(t, []string{"myUnion"}, inCfg.Types)
 		assert.Equal(t, "mypkg", outCfg.OutPkg)
 	})
 
@@ -91,7 +191,7 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion"})
 		require.NoError(t, err)
 
-		_, _, err = parseFlags(cmd.Flags())
+		_, _, err = parseFlags(cmd.Flags(), nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "one of src or GOFILE must be set")
 	})
@@ -104,7 +204,7 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion"})
 		require.NoError(t, err)
 
-		_, _, err = parseFlags(cmd.Flags())
+		_, _, err = parseFlags(cmd.Flags(), nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "one of out-pkg or GOPACKAGE must be set")
 	})
@@ -117,8 +217,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 		assert.Equal(t, "MyUnionUnion", outCfg.OutType)
 	})
 
@@ -130,8 +232,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "x"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 		assert.Equal(t, "XUnion", outCfg.OutType)
 	})
 
@@ -143,8 +247,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--src", "types.go"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 		assert.Equal(t, "types_gunion.go", outCfg.OutFile)
 	})
 
@@ -156,8 +262,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--src", "internal/types/types.go"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 		assert.Equal(t, "internal/types/types_gunion.go", outCfg.OutFile)
 	})
 
@@ -172,30 +280,60 @@ func TestParseFlags(t *testing.T) {
 			"--src", "source.go",
 			"--out-file", "output.go",
 			"--out-pkg", "outpkg",
+			"--out-pkg-path", "example.com/outpkg",
 			"--no-getters",
 			"--no-setters",
 			"--no-match",
+			"--match-e",
+			"--switch",
+			"--match-or",
+			"--visitor",
 			"--no-default",
+			"--kind",
+			"--stable-variants",
+			"--sealed",
+			"--compact",
+			"--equal",
+			"--format",
+			"--slog",
+			"--naming", "camel",
+			"--name-template", "is=Has{Variant},get=Find{Variant}",
 		})
 		require.NoError(t, err)
 
-		inCfg, outCfg, err := parseFlags(cmd.Flags())
+		inCfg, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 
 		absPath, _ := filepath.Abs("source.go")
 		assert.Equal(t, config.InputConfig{
 			Source: absPath,
-			Type:   "inputType",
+			Types:  []string{"inputType"},
 		}, inCfg)
 
 		assert.Equal(t, config.OutputConfig{
-			OutType: "OutputType",
-			OutFile: "output.go",
-			OutPkg:  "outpkg",
-			Getters: false,
-			Setters: false,
-			Match:   false,
-			Default: false,
+			OutType:        "OutputType",
+			OutFile:        "output.go",
+			OutPkg:         "outpkg",
+			OutPkgPath:     "example.com/outpkg",
+			Getters:        false,
+			Setters:        false,
+			Match:          false,
+			MatchE:         true,
+			Switch:         true,
+			MatchOr:        true,
+			Visitor:        true,
+			Default:        false,
+			Kind:           true,
+			StableVariants: true,
+			Sealed:         true,
+			Compact:        true,
+			Equal:          true,
+			Format:         true,
+			Slog:           true,
+			Naming:         config.NamingCamel,
+			NameTemplates:  map[string]string{"is": "Has{Variant}", "get": "Find{Variant}"},
 		}, outCfg)
 	})
 
@@ -207,8 +345,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 
 		// By default, all features are enabled (no-* flags are false)
 		assert.True(t, outCfg.Getters)
@@ -225,10 +365,12 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"-t", "myType", "--src", "file.go", "-o", "out.go"})
 		require.NoError(t, err)
 
-		inCfg, outCfg, err := parseFlags(cmd.Flags())
+		inCfg, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 
-		assert.Equal(t, "myType", inCfg.Type)
+		assert.Equal(t, []string{"myType"}, inCfg.Types)
 		assert.Equal(t, "out.go", outCfg.OutFile)
 	})
 
@@ -240,8 +382,10 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--out-pkg", "flagpkg"})
 		require.NoError(t, err)
 
-		_, outCfg, err := parseFlags(cmd.Flags())
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
 		assert.Equal(t, "flagpkg", outCfg.OutPkg)
 	})
 
@@ -253,13 +397,178 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--src", "flag.go"})
 		require.NoError(t, err)
 
-		inCfg, _, err := parseFlags(cmd.Flags())
+		inCfg, _, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
 
 		absPath, _ := filepath.Abs("flag.go")
 		assert.Equal(t, absPath, inCfg.Source)
 	})
 
+	t.Run("json style is parsed", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--json", "adjacent"})
+		require.NoError(t, err)
+
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		outCfg := outCfgs[0]
+		assert.Equal(t, config.JSONAdjacent, outCfg.JSON)
+	})
+
+	t.Run("invalid json style errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--json", "sideways"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid json style "sideways"`)
+	})
+
+	t.Run("sql encoding is parsed", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--sql", "columns"})
+		require.NoError(t, err)
+
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 1)
+		assert.Equal(t, config.SQLColumns, outCfgs[0].SQL)
+	})
+
+	t.Run("invalid sql encoding errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--sql", "xml"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, `invalid sql encoding "xml": must be one of json, columns`)
+	})
+
+	t.Run("invalid naming scheme errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--naming", "kebab"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, `invalid naming scheme "kebab": must be one of underscore, camel`)
+	})
+
+	t.Run("multiple types share one output file", func(t *testing.T) {
+		os.Setenv("GOFILE", "shapes.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event", "--type", "color", "--out-type", "Shape,Event,Color"})
+		require.NoError(t, err)
+
+		inCfg, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"shape", "event", "color"}, inCfg.Types)
+		require.Len(t, outCfgs, 3)
+		assert.Equal(t, "Shape", outCfgs[0].OutType)
+		assert.Equal(t, "Event", outCfgs[1].OutType)
+		assert.Equal(t, "Color", outCfgs[2].OutType)
+		for _, outCfg := range outCfgs {
+			assert.Equal(t, "shapes_gunion.go", outCfg.OutFile)
+		}
+	})
+
+	t.Run("multiple types with default out-types", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event"})
+		require.NoError(t, err)
+
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 2)
+		assert.Equal(t, "ShapeUnion", outCfgs[0].OutType)
+		assert.Equal(t, "EventUnion", outCfgs[1].OutType)
+	})
+
+	t.Run("split writes one file per type", func(t *testing.T) {
+		os.Setenv("GOFILE", "shapes.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event", "--split"})
+		require.NoError(t, err)
+
+		_, outCfgs, err := parseFlags(cmd.Flags(), nil)
+		require.NoError(t, err)
+		require.Len(t, outCfgs, 2)
+		assert.Equal(t, "shapes_shape_gunion.go", outCfgs[0].OutFile)
+		assert.Equal(t, "shapes_event_gunion.go", outCfgs[1].OutFile)
+	})
+
+	t.Run("split with out-file errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event", "--split", "-o", "out.go"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "out-file cannot be used with split")
+	})
+
+	t.Run("out-type count must match type count", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event", "--out-type", "Shape"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "got 1 out-types for 2 types: give one out-type per type, or none")
+	})
+
+	t.Run("duplicate type errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape", "--type", "shape"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "input type shape given more than once")
+	})
+
+	t.Run("duplicate out-type errors", func(t *testing.T) {
+		os.Setenv("GOFILE", "test.go")
+		os.Setenv("GOPACKAGE", "testpkg")
+
+		cmd := newTestCmd()
+		err := cmd.Flags().Parse([]string{"--type", "shape,event", "--out-type", "Union,Union"})
+		require.NoError(t, err)
+
+		_, _, err = parseFlags(cmd.Flags(), nil)
+		assert.EqualError(t, err, "out-type Union given more than once")
+	})
+
 	t.Run("source path is converted to absolute", func(t *testing.T) {
 		os.Setenv("GOFILE", "")
 		os.Setenv("GOPACKAGE", "testpkg")
@@ -268,7 +577,7 @@ func TestParseFlags(t *testing.T) {
 		err := cmd.Flags().Parse([]string{"--type", "myUnion", "--src", "./relative/path/file.go"})
 		require.NoError(t, err)
 
-		inCfg, _, err := parseFlags(cmd.Flags())
+		inCfg, _, err := parseFlags(cmd.Flags(), nil)
 		require.NoError(t, err)
 
 		assert.True(t, filepath.IsAbs(inCfg.Source))
@@ -306,17 +615,17 @@ func TestRunE(t *testing.T) {
 		os.Setenv("GOPACKAGE", "testpkg")
 		os.Args = []string{"gunion", "--type", "myUnion", "--src", "test.go", "--out-pkg", "testpkg"}
 
-		mockLdr := &mockLoader{result: fakeNamed}
+		mockLdr := &mockLoader{result: []types.Named{fakeNamed}}
 		mockGen := &mockGenerator{}
 
 		var capturedInCfg config.InputConfig
-		var capturedOutCfg config.OutputConfig
+		var capturedOutCfgs []config.OutputConfig
 		LoaderFactory = func(cfg config.InputConfig) Loader {
 			capturedInCfg = cfg
 			return mockLdr
 		}
-		GeneratorFactory = func(cfg config.OutputConfig) Generator {
-			capturedOutCfg = cfg
+		GeneratorFactory = func(cfgs ...config.OutputConfig) Generator {
+			capturedOutCfgs = cfgs
 			return mockGen
 		}
 
@@ -326,10 +635,12 @@ func TestRunE(t *testing.T) {
 		require.NoError(t, err)
 
 		// Loader received the right input config.
-		assert.Equal(t, "myUnion", capturedInCfg.Type)
+		assert.Equal(t, []string{"myUnion"}, capturedInCfg.Types)
 		assert.True(t, filepath.IsAbs(capturedInCfg.Source))
 
 		// Generator received the right output config.
+		require.Len(t, capturedOutCfgs, 1)
+		capturedOutCfg := capturedOutCfgs[0]
 		assert.Equal(t, "MyUnionUnion", capturedOutCfg.OutType)
 		assert.Equal(t, "testpkg", capturedOutCfg.OutPkg)
 		assert.True(t, capturedOutCfg.Getters)
@@ -339,7 +650,42 @@ func TestRunE(t *testing.T) {
 
 		// Generator was called with the loader's output.
 		assert.True(t, mockGen.called)
-		assert.Equal(t, fakeNamed, mockGen.received)
+		assert.Equal(t, []types.Named{fakeNamed}, mockGen.received)
+	})
+
+	t.Run("discovers types when no type is given", func(t *testing.T) {
+		os.Setenv("GOFILE", "")
+		os.Setenv("GOPACKAGE", "")
+		os.Args = []string{"gunion", "./..."}
+
+		mockLdr := &mockLoader{directives: []loader.Directive{
+			{Named: fakeNamed, File: "/src/pkg/shapes.go", PkgName: "pkg", Options: []string{"out=Shape"}},
+		}}
+		mockGen := &mockGenerator{}
+
+		var capturedInCfg config.InputConfig
+		var capturedOutCfgs []config.OutputConfig
+		LoaderFactory = func(cfg config.InputConfig) Loader {
+			capturedInCfg = cfg
+			return mockLdr
+		}
+		GeneratorFactory = func(cfgs ...config.OutputConfig) Generator {
+			capturedOutCfgs = cfgs
+			return mockGen
+		}
+
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{"./..."})
+		err := cmd.Execute()
+		require.NoError(t, err)
+
+		assert.Equal(t, config.InputConfig{Patterns: []string{"./..."}}, capturedInCfg)
+		require.Len(t, capturedOutCfgs, 1)
+		assert.Equal(t, "Shape", capturedOutCfgs[0].OutType)
+		assert.Equal(t, "/src/pkg/shapes_gunion.go", capturedOutCfgs[0].OutFile)
+		assert.Equal(t, "pkg", capturedOutCfgs[0].OutPkg)
+		assert.Equal(t, "gunion ./...", capturedOutCfgs[0].Command)
+		assert.Equal(t, []types.Named{fakeNamed}, mockGen.received)
 	})
 
 	t.Run("loader error is propagated", func(t *testing.T) {
@@ -350,7 +696,7 @@ func TestRunE(t *testing.T) {
 		mockGen := &mockGenerator{}
 
 		LoaderFactory = func(cfg config.InputConfig) Loader { return mockLdr }
-		GeneratorFactory = func(cfg config.OutputConfig) Generator { return mockGen }
+		GeneratorFactory = func(cfgs ...config.OutputConfig) Generator { return mockGen }
 
 		cmd := newRootCmd()
 		cmd.SetArgs([]string{"--type", "myUnion", "--src", "test.go", "--out-pkg", "testpkg"})
@@ -367,11 +713,11 @@ func TestRunE(t *testing.T) {
 		os.Setenv("GOFILE", "test.go")
 		os.Setenv("GOPACKAGE", "testpkg")
 
-		mockLdr := &mockLoader{result: fakeNamed}
+		mockLdr := &mockLoader{result: []types.Named{fakeNamed}}
 		mockGen := &mockGenerator{err: errors.New("generate failed")}
 
 		LoaderFactory = func(cfg config.InputConfig) Loader { return mockLdr }
-		GeneratorFactory = func(cfg config.OutputConfig) Generator { return mockGen }
+		GeneratorFactory = func(cfgs ...config.OutputConfig) Generator { return mockGen }
 
 		cmd := newRootCmd()
 		cmd.SetArgs([]string{"--type", "myUnion", "--src", "test.go", "--out-pkg", "testpkg"})
@@ -0,0 +1,22 @@
+package example
+
+// The two unions below have the same variants. wideValue embeds every payload, while compactValue
+// only stores the active one.
+
+//go:generate go run .. --type wideValue --out-type WideValue --no-default -o compact_wide_gunion.go
+
+type wideValue struct {
+	number int
+	name   string
+	matrix [4][4]float64
+	buffer [64]byte
+}
+
+//go:generate go run .. --type compactValue --out-type CompactValue --no-default --compact -o compact_gunion.go
+
+type compactValue struct {
+	number int
+	name   string
+	matrix [4][4]float64
+	buffer [64]byte
+}
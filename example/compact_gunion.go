@@ -0,0 +1,274 @@
+// Code generated by gunion via `/root/.cache/go-build/d9/d9bb9e112a17d912aa9731cbda4c4fe0a50d6a0d45fe55b406af9fb605976f2b-d/gunion --type compactValue --out-type CompactValue --no-default --compact -o compact_gunion.go`. DO NOT EDIT.
+
+package example
+
+import gunion "github.com/sidkurella/gunion/gunion"
+
+type _compactValueVariant int
+
+const (
+	_compactValueVariant_Invalid _compactValueVariant = 0
+	_compactValueVariant_number  _compactValueVariant = 1
+	_compactValueVariant_name    _compactValueVariant = 2
+	_compactValueVariant_matrix  _compactValueVariant = 3
+	_compactValueVariant_buffer  _compactValueVariant = 4
+)
+
+func (v _compactValueVariant) String() string {
+	switch v {
+	case _compactValueVariant_Invalid:
+		return "Invalid"
+	case _compactValueVariant_number:
+		return "number"
+	case _compactValueVariant_name:
+		return "name"
+	case _compactValueVariant_matrix:
+		return "matrix"
+	case _compactValueVariant_buffer:
+		return "buffer"
+	default:
+		return "unknown"
+	}
+}
+
+func _compactValueValue[T any](v any) T {
+	if val, ok := v.(*T); ok {
+		return *val
+	}
+	var zero T
+	return zero
+}
+
+func _compactValueBox[T any](v T) *T {
+	return &v
+}
+
+type CompactValue struct {
+	_variant _compactValueVariant
+	_value   any
+}
+
+func (u *CompactValue) Is_Invalid() bool {
+	return u._variant == _compactValueVariant_Invalid
+}
+
+func NewCompactValue_Invalid() CompactValue {
+	return CompactValue{_variant: _compactValueVariant_Invalid}
+}
+
+func (u *CompactValue) Set_Invalid() {
+	*u = CompactValue{_variant: _compactValueVariant_Invalid}
+}
+
+func (u *CompactValue) Is_number() bool {
+	return u._variant == _compactValueVariant_number
+}
+
+func (u *CompactValue) Unwrap_number() int {
+	if u._variant != _compactValueVariant_number {
+		panic(&gunion.WrongVariantError{Union: "CompactValue", Want: "number", Got: u._variant.String()})
+	}
+	return _compactValueValue[int](u._value)
+}
+
+func (u *CompactValue) Get_number() (int, bool) {
+	if u._variant == _compactValueVariant_number {
+		return _compactValueValue[int](u._value), true
+	}
+	var zero int
+	return zero, false
+}
+
+func (u *CompactValue) Try_number() (int, error) {
+	if u._variant != _compactValueVariant_number {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "CompactValue", Want: "number", Got: u._variant.String()}
+	}
+	return _compactValueValue[int](u._value), nil
+}
+
+func (u *CompactValue) Ptr_number() *int {
+	if u._variant != _compactValueVariant_number {
+		return nil
+	}
+	val, _ := u._value.(*int)
+	return val
+}
+
+func NewCompactValue_number(val int) CompactValue {
+	return CompactValue{
+		_value:   _compactValueBox[int](val),
+		_variant: _compactValueVariant_number,
+	}
+}
+
+func (u *CompactValue) Set_number(val int) {
+	*u = CompactValue{
+		_value:   _compactValueBox[int](val),
+		_variant: _compactValueVariant_number,
+	}
+}
+
+func (u *CompactValue) Is_name() bool {
+	return u._variant == _compactValueVariant_name
+}
+
+func (u *CompactValue) Unwrap_name() string {
+	if u._variant != _compactValueVariant_name {
+		panic(&gunion.WrongVariantError{Union: "CompactValue", Want: "name", Got: u._variant.String()})
+	}
+	return _compactValueValue[string](u._value)
+}
+
+func (u *CompactValue) Get_name() (string, bool) {
+	if u._variant == _compactValueVariant_name {
+		return _compactValueValue[string](u._value), true
+	}
+	var zero string
+	return zero, false
+}
+
+func (u *CompactValue) Try_name() (string, error) {
+	if u._variant != _compactValueVariant_name {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "CompactValue", Want: "name", Got: u._variant.String()}
+	}
+	return _compactValueValue[string](u._value), nil
+}
+
+func (u *CompactValue) Ptr_name() *string {
+	if u._variant != _compactValueVariant_name {
+		return nil
+	}
+	val, _ := u._value.(*string)
+	return val
+}
+
+func NewCompactValue_name(val string) CompactValue {
+	return CompactValue{
+		_value:   _compactValueBox[string](val),
+		_variant: _compactValueVariant_name,
+	}
+}
+
+func (u *CompactValue) Set_name(val string) {
+	*u = CompactValue{
+		_value:   _compactValueBox[string](val),
+		_variant: _compactValueVariant_name,
+	}
+}
+
+func (u *CompactValue) Is_matrix() bool {
+	return u._variant == _compactValueVariant_matrix
+}
+
+func (u *CompactValue) Unwrap_matrix() [4][4]float64 {
+	if u._variant != _compactValueVariant_matrix {
+		panic(&gunion.WrongVariantError{Union: "CompactValue", Want: "matrix", Got: u._variant.String()})
+	}
+	return _compactValueValue[[4][4]float64](u._value)
+}
+
+func (u *CompactValue) Get_matrix() ([4][4]float64, bool) {
+	if u._variant == _compactValueVariant_matrix {
+		return _compactValueValue[[4][4]float64](u._value), true
+	}
+	var zero [4][4]float64
+	return zero, false
+}
+
+func (u *CompactValue) Try_matrix() ([4][4]float64, error) {
+	if u._variant != _compactValueVariant_matrix {
+		var zero [4][4]float64
+		return zero, &gunion.WrongVariantError{Union: "CompactValue", Want: "matrix", Got: u._variant.String()}
+	}
+	return _compactValueValue[[4][4]float64](u._value), nil
+}
+
+func (u *CompactValue) Ptr_matrix() *[4][4]float64 {
+	if u._variant != _compactValueVariant_matrix {
+		return nil
+	}
+	val, _ := u._value.(*[4][4]float64)
+	return val
+}
+
+func NewCompactValue_matrix(val [4][4]float64) CompactValue {
+	return CompactValue{
+		_value:   _compactValueBox[[4][4]float64](val),
+		_variant: _compactValueVariant_matrix,
+	}
+}
+
+func (u *CompactValue) Set_matrix(val [4][4]float64) {
+	*u = CompactValue{
+		_value:   _compactValueBox[[4][4]float64](val),
+		_variant: _compactValueVariant_matrix,
+	}
+}
+
+func (u *CompactValue) Is_buffer() bool {
+	return u._variant == _compactValueVariant_buffer
+}
+
+func (u *CompactValue) Unwrap_buffer() [64]byte {
+	if u._variant != _compactValueVariant_buffer {
+		panic(&gunion.WrongVariantError{Union: "CompactValue", Want: "buffer", Got: u._variant.String()})
+	}
+	return _compactValueValue[[64]byte](u._value)
+}
+
+func (u *CompactValue) Get_buffer() ([64]byte, bool) {
+	if u._variant == _compactValueVariant_buffer {
+		return _compactValueValue[[64]byte](u._value), true
+	}
+	var zero [64]byte
+	return zero, false
+}
+
+func (u *CompactValue) Try_buffer() ([64]byte, error) {
+	if u._variant != _compactValueVariant_buffer {
+		var zero [64]byte
+		return zero, &gunion.WrongVariantError{Union: "CompactValue", Want: "buffer", Got: u._variant.String()}
+	}
+	return _compactValueValue[[64]byte](u._value), nil
+}
+
+func (u *CompactValue) Ptr_buffer() *[64]byte {
+	if u._variant != _compactValueVariant_buffer {
+		return nil
+	}
+	val, _ := u._value.(*[64]byte)
+	return val
+}
+
+func NewCompactValue_buffer(val [64]byte) CompactValue {
+	return CompactValue{
+		_value:   _compactValueBox[[64]byte](val),
+		_variant: _compactValueVariant_buffer,
+	}
+}
+
+func (u *CompactValue) Set_buffer(val [64]byte) {
+	*u = CompactValue{
+		_value:   _compactValueBox[[64]byte](val),
+		_variant: _compactValueVariant_buffer,
+	}
+}
+
+func Match_CompactValue[_R any](u *CompactValue, on_number func(int) _R, on_name func(string) _R, on_matrix func([4][4]float64) _R, on_buffer func([64]byte) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _compactValueVariant_number:
+		return on_number(_compactValueValue[int](u._value))
+	case _compactValueVariant_name:
+		return on_name(_compactValueValue[string](u._value))
+	case _compactValueVariant_matrix:
+		return on_matrix(_compactValueValue[[4][4]float64](u._value))
+	case _compactValueVariant_buffer:
+		return on_buffer(_compactValueValue[[64]byte](u._value))
+	case _compactValueVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
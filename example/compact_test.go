@@ -0,0 +1,84 @@
+package example
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact(t *testing.T) {
+	t.Run("same API as the wide layout", func(t *testing.T) {
+		u := NewCompactValue_name("gunion")
+		assert.True(t, u.Is_name())
+		assert.Equal(t, "gunion", u.Unwrap_name())
+
+		_, ok := u.Get_number()
+		assert.False(t, ok)
+
+		size := Match_CompactValue(&u,
+			func(int) int { return 0 },
+			func(s string) int { return len(s) },
+			func([4][4]float64) int { return 0 },
+			func([64]byte) int { return 0 },
+			func() int { return -1 },
+		)
+		assert.Equal(t, 6, size)
+	})
+
+	t.Run("updates payloads in place", func(t *testing.T) {
+		u := NewCompactValue_number(1)
+		*u.Ptr_number() += 41
+		assert.Equal(t, 42, u.Unwrap_number())
+		assert.Nil(t, u.Ptr_name())
+	})
+
+	t.Run("zero value is invalid", func(t *testing.T) {
+		var u CompactValue
+		assert.True(t, u.Is_Invalid())
+	})
+
+	t.Run("only stores the active payload", func(t *testing.T) {
+		assert.Less(t, int(unsafe.Sizeof(CompactValue{})), int(unsafe.Sizeof(WideValue{})))
+	})
+}
+
+// The layout benchmarks build a slice of unions and sum their number payloads, reporting the size of
+// a single union value alongside the allocations. The compact layout is much smaller, but storing a
+// payload allocates the pointer it is kept behind.
+
+func BenchmarkWideLayout(b *testing.B) {
+	b.ReportAllocs()
+	values := make([]WideValue, 100)
+	for b.Loop() {
+		for i := range values {
+			values[i] = NewWideValue_number(1000 + i)
+		}
+		sum := 0
+		for i := range values {
+			sum += values[i].Unwrap_number()
+		}
+		if sum != 104950 {
+			b.Fatalf("got sum %d", sum)
+		}
+	}
+	b.ReportMetric(float64(unsafe.Sizeof(WideValue{})), "B/value")
+}
+
+func BenchmarkCompactLayout(b *testing.B) {
+	b.ReportAllocs()
+	values := make([]CompactValue, 100)
+	for b.Loop() {
+		for i := range values {
+			values[i] = NewCompactValue_number(1000 + i)
+		}
+		sum := 0
+		for i := range values {
+			sum += values[i].Unwrap_number()
+		}
+		if sum != 104950 {
+			b.Fatalf("got sum %d", sum)
+		}
+	}
+	b.ReportMetric(float64(unsafe.Sizeof(CompactValue{})), "B/value")
+}
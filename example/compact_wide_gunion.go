@@ -0,0 +1,258 @@
+// Code generated by gunion via `/tmp/go-build1647006134/b001/exe/gunion --type wideValue --out-type WideValue --no-default -o compact_wide_gunion.go`. DO NOT EDIT.
+
+package example
+
+import gunion "github.com/sidkurella/gunion/gunion"
+
+type _wideValueVariant int
+
+const (
+	_wideValueVariant_Invalid _wideValueVariant = 0
+	_wideValueVariant_number  _wideValueVariant = 1
+	_wideValueVariant_name    _wideValueVariant = 2
+	_wideValueVariant_matrix  _wideValueVariant = 3
+	_wideValueVariant_buffer  _wideValueVariant = 4
+)
+
+func (v _wideValueVariant) String() string {
+	switch v {
+	case _wideValueVariant_Invalid:
+		return "Invalid"
+	case _wideValueVariant_number:
+		return "number"
+	case _wideValueVariant_name:
+		return "name"
+	case _wideValueVariant_matrix:
+		return "matrix"
+	case _wideValueVariant_buffer:
+		return "buffer"
+	default:
+		return "unknown"
+	}
+}
+
+type WideValue struct {
+	_variant _wideValueVariant
+	_inner   wideValue
+}
+
+func (u *WideValue) Is_Invalid() bool {
+	return u._variant == _wideValueVariant_Invalid
+}
+
+func NewWideValue_Invalid() WideValue {
+	return WideValue{_variant: _wideValueVariant_Invalid}
+}
+
+func (u *WideValue) Set_Invalid() {
+	*u = WideValue{_variant: _wideValueVariant_Invalid}
+}
+
+func (u *WideValue) Is_number() bool {
+	return u._variant == _wideValueVariant_number
+}
+
+func (u *WideValue) Unwrap_number() int {
+	if u._variant != _wideValueVariant_number {
+		panic(&gunion.WrongVariantError{Union: "WideValue", Want: "number", Got: u._variant.String()})
+	}
+	return u._inner.number
+}
+
+func (u *WideValue) Get_number() (int, bool) {
+	if u._variant == _wideValueVariant_number {
+		return u._inner.number, true
+	}
+	var zero int
+	return zero, false
+}
+
+func (u *WideValue) Try_number() (int, error) {
+	if u._variant != _wideValueVariant_number {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "WideValue", Want: "number", Got: u._variant.String()}
+	}
+	return u._inner.number, nil
+}
+
+func (u *WideValue) Ptr_number() *int {
+	if u._variant != _wideValueVariant_number {
+		return nil
+	}
+	return &u._inner.number
+}
+
+func NewWideValue_number(val int) WideValue {
+	return WideValue{
+		_inner:   wideValue{number: val},
+		_variant: _wideValueVariant_number,
+	}
+}
+
+func (u *WideValue) Set_number(val int) {
+	*u = WideValue{
+		_inner:   wideValue{number: val},
+		_variant: _wideValueVariant_number,
+	}
+}
+
+func (u *WideValue) Is_name() bool {
+	return u._variant == _wideValueVariant_name
+}
+
+func (u *WideValue) Unwrap_name() string {
+	if u._variant != _wideValueVariant_name {
+		panic(&gunion.WrongVariantError{Union: "WideValue", Want: "name", Got: u._variant.String()})
+	}
+	return u._inner.name
+}
+
+func (u *WideValue) Get_name() (string, bool) {
+	if u._variant == _wideValueVariant_name {
+		return u._inner.name, true
+	}
+	var zero string
+	return zero, false
+}
+
+func (u *WideValue) Try_name() (string, error) {
+	if u._variant != _wideValueVariant_name {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "WideValue", Want: "name", Got: u._variant.String()}
+	}
+	return u._inner.name, nil
+}
+
+func (u *WideValue) Ptr_name() *string {
+	if u._variant != _wideValueVariant_name {
+		return nil
+	}
+	return &u._inner.name
+}
+
+func NewWideValue_name(val string) WideValue {
+	return WideValue{
+		_inner:   wideValue{name: val},
+		_variant: _wideValueVariant_name,
+	}
+}
+
+func (u *WideValue) Set_name(val string) {
+	*u = WideValue{
+		_inner:   wideValue{name: val},
+		_variant: _wideValueVariant_name,
+	}
+}
+
+func (u *WideValue) Is_matrix() bool {
+	return u._variant == _wideValueVariant_matrix
+}
+
+func (u *WideValue) Unwrap_matrix() [4][4]float64 {
+	if u._variant != _wideValueVariant_matrix {
+		panic(&gunion.WrongVariantError{Union: "WideValue", Want: "matrix", Got: u._variant.String()})
+	}
+	return u._inner.matrix
+}
+
+func (u *WideValue) Get_matrix() ([4][4]float64, bool) {
+	if u._variant == _wideValueVariant_matrix {
+		return u._inner.matrix, true
+	}
+	var zero [4][4]float64
+	return zero, false
+}
+
+func (u *WideValue) Try_matrix() ([4][4]float64, error) {
+	if u._variant != _wideValueVariant_matrix {
+		var zero [4][4]float64
+		return zero, &gunion.WrongVariantError{Union: "WideValue", Want: "matrix", Got: u._variant.String()}
+	}
+	return u._inner.matrix, nil
+}
+
+func (u *WideValue) Ptr_matrix() *[4][4]float64 {
+	if u._variant != _wideValueVariant_matrix {
+		return nil
+	}
+	return &u._inner.matrix
+}
+
+func NewWideValue_matrix(val [4][4]float64) WideValue {
+	return WideValue{
+		_inner:   wideValue{matrix: val},
+		_variant: _wideValueVariant_matrix,
+	}
+}
+
+func (u *WideValue) Set_matrix(val [4][4]float64) {
+	*u = WideValue{
+		_inner:   wideValue{matrix: val},
+		_variant: _wideValueVariant_matrix,
+	}
+}
+
+func (u *WideValue) Is_buffer() bool {
+	return u._variant == _wideValueVariant_buffer
+}
+
+func (u *WideValue) Unwrap_buffer() [64]byte {
+	if u._variant != _wideValueVariant_buffer {
+		panic(&gunion.WrongVariantError{Union: "WideValue", Want: "buffer", Got: u._variant.String()})
+	}
+	return u._inner.buffer
+}
+
+func (u *WideValue) Get_buffer() ([64]byte, bool) {
+	if u._variant == _wideValueVariant_buffer {
+		return u._inner.buffer, true
+	}
+	var zero [64]byte
+	return zero, false
+}
+
+func (u *WideValue) Try_buffer() ([64]byte, error) {
+	if u._variant != _wideValueVariant_buffer {
+		var zero [64]byte
+		return zero, &gunion.WrongVariantError{Union: "WideValue", Want: "buffer", Got: u._variant.String()}
+	}
+	return u._inner.buffer, nil
+}
+
+func (u *WideValue) Ptr_buffer() *[64]byte {
+	if u._variant != _wideValueVariant_buffer {
+		return nil
+	}
+	return &u._inner.buffer
+}
+
+func NewWideValue_buffer(val [64]byte) WideValue {
+	return WideValue{
+		_inner:   wideValue{buffer: val},
+		_variant: _wideValueVariant_buffer,
+	}
+}
+
+func (u *WideValue) Set_buffer(val [64]byte) {
+	*u = WideValue{
+		_inner:   wideValue{buffer: val},
+		_variant: _wideValueVariant_buffer,
+	}
+}
+
+func Match_WideValue[_R any](u *WideValue, on_number func(int) _R, on_name func(string) _R, on_matrix func([4][4]float64) _R, on_buffer func([64]byte) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _wideValueVariant_number:
+		return on_number(u._inner.number)
+	case _wideValueVariant_name:
+		return on_name(u._inner.name)
+	case _wideValueVariant_matrix:
+		return on_matrix(u._inner.matrix)
+	case _wideValueVariant_buffer:
+		return on_buffer(u._inner.buffer)
+	case _wideValueVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
@@ -19,17 +19,24 @@ func TestGoGenerate(t *testing.T) {
 
 	genFile := filepath.Join(exampleDir, "shape_gunion.go")
 
-	// Read the existing committed generated file for comparison.
-	committed, err := os.ReadFile(genFile)
+	// go generate rewrites every generated file in the package, so snapshot them all for restoring.
+	genFiles, err := filepath.Glob(filepath.Join(exampleDir, "*_gunion.go"))
 	require.NoError(t, err)
+	snapshots := make(map[string][]byte, len(genFiles))
+	for _, f := range genFiles {
+		snapshots[f], err = os.ReadFile(f)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		// Restore the generated files.
+		for f, contents := range snapshots {
+			_ = os.WriteFile(f, contents, 0644)
+		}
+	})
 
 	// Remove it so we can verify go:generate recreates it.
 	err = os.Remove(genFile)
 	require.NoError(t, err)
-	t.Cleanup(func() {
-		// Restore the generated file.
-		_ = os.WriteFile(genFile, committed, 0644)
-	})
 
 	// Run go generate.
 	cmd := exec.Command("go", "generate", ".")
@@ -0,0 +1,34 @@
+package example
+
+// Each union below encodes to JSON with a different variant tagging style.
+
+//go:generate go run .. --type externalShape --out-type ExternalShape --json external --no-default -o json_external_gunion.go
+
+type externalShape struct {
+	circle    float64
+	rectangle [2]float64
+}
+
+//go:generate go run .. --type adjacentShape --out-type AdjacentShape --json adjacent --no-default -o json_adjacent_gunion.go
+
+type adjacentShape struct {
+	circle    float64
+	rectangle [2]float64
+	empty     struct{}
+}
+
+type click struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type keyPress struct {
+	Key string `json:"key"`
+}
+
+//go:generate go run .. --type event --out-type Event --json internal --no-default -o json_internal_gunion.go
+
+type event struct {
+	click    click
+	keyPress keyPress
+}
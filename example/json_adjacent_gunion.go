@@ -0,0 +1,238 @@
+// Code generated by gunion via `/root/.cache/go-build/d6/d67f6c57939c6db72cf9085a10056fa8980a727f77dd12d140a14968cb54910a-d/gunion --type adjacentShape --out-type AdjacentShape --json adjacent --no-default -o json_adjacent_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+)
+
+type _adjacentShapeVariant int
+
+const (
+	_adjacentShapeVariant_Invalid   _adjacentShapeVariant = 0
+	_adjacentShapeVariant_circle    _adjacentShapeVariant = 1
+	_adjacentShapeVariant_rectangle _adjacentShapeVariant = 2
+	_adjacentShapeVariant_empty     _adjacentShapeVariant = 3
+)
+
+func (v _adjacentShapeVariant) String() string {
+	switch v {
+	case _adjacentShapeVariant_Invalid:
+		return "Invalid"
+	case _adjacentShapeVariant_circle:
+		return "circle"
+	case _adjacentShapeVariant_rectangle:
+		return "rectangle"
+	case _adjacentShapeVariant_empty:
+		return "empty"
+	default:
+		return "unknown"
+	}
+}
+
+type AdjacentShape struct {
+	_variant _adjacentShapeVariant
+	_inner   adjacentShape
+}
+
+func (u *AdjacentShape) Is_Invalid() bool {
+	return u._variant == _adjacentShapeVariant_Invalid
+}
+
+func NewAdjacentShape_Invalid() AdjacentShape {
+	return AdjacentShape{_variant: _adjacentShapeVariant_Invalid}
+}
+
+func (u *AdjacentShape) Set_Invalid() {
+	*u = AdjacentShape{_variant: _adjacentShapeVariant_Invalid}
+}
+
+func (u *AdjacentShape) Is_circle() bool {
+	return u._variant == _adjacentShapeVariant_circle
+}
+
+func (u *AdjacentShape) Unwrap_circle() float64 {
+	if u._variant != _adjacentShapeVariant_circle {
+		panic(&gunion.WrongVariantError{Union: "AdjacentShape", Want: "circle", Got: u._variant.String()})
+	}
+	return u._inner.circle
+}
+
+func (u *AdjacentShape) Get_circle() (float64, bool) {
+	if u._variant == _adjacentShapeVariant_circle {
+		return u._inner.circle, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *AdjacentShape) Try_circle() (float64, error) {
+	if u._variant != _adjacentShapeVariant_circle {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "AdjacentShape", Want: "circle", Got: u._variant.String()}
+	}
+	return u._inner.circle, nil
+}
+
+func (u *AdjacentShape) Ptr_circle() *float64 {
+	if u._variant != _adjacentShapeVariant_circle {
+		return nil
+	}
+	return &u._inner.circle
+}
+
+func NewAdjacentShape_circle(val float64) AdjacentShape {
+	return AdjacentShape{
+		_inner:   adjacentShape{circle: val},
+		_variant: _adjacentShapeVariant_circle,
+	}
+}
+
+func (u *AdjacentShape) Set_circle(val float64) {
+	*u = AdjacentShape{
+		_inner:   adjacentShape{circle: val},
+		_variant: _adjacentShapeVariant_circle,
+	}
+}
+
+func (u *AdjacentShape) Is_rectangle() bool {
+	return u._variant == _adjacentShapeVariant_rectangle
+}
+
+func (u *AdjacentShape) Unwrap_rectangle() [2]float64 {
+	if u._variant != _adjacentShapeVariant_rectangle {
+		panic(&gunion.WrongVariantError{Union: "AdjacentShape", Want: "rectangle", Got: u._variant.String()})
+	}
+	return u._inner.rectangle
+}
+
+func (u *AdjacentShape) Get_rectangle() ([2]float64, bool) {
+	if u._variant == _adjacentShapeVariant_rectangle {
+		return u._inner.rectangle, true
+	}
+	var zero [2]float64
+	return zero, false
+}
+
+func (u *AdjacentShape) Try_rectangle() ([2]float64, error) {
+	if u._variant != _adjacentShapeVariant_rectangle {
+		var zero [2]float64
+		return zero, &gunion.WrongVariantError{Union: "AdjacentShape", Want: "rectangle", Got: u._variant.String()}
+	}
+	return u._inner.rectangle, nil
+}
+
+func (u *AdjacentShape) Ptr_rectangle() *[2]float64 {
+	if u._variant != _adjacentShapeVariant_rectangle {
+		return nil
+	}
+	return &u._inner.rectangle
+}
+
+func NewAdjacentShape_rectangle(val [2]float64) AdjacentShape {
+	return AdjacentShape{
+		_inner:   adjacentShape{rectangle: val},
+		_variant: _adjacentShapeVariant_rectangle,
+	}
+}
+
+func (u *AdjacentShape) Set_rectangle(val [2]float64) {
+	*u = AdjacentShape{
+		_inner:   adjacentShape{rectangle: val},
+		_variant: _adjacentShapeVariant_rectangle,
+	}
+}
+
+func (u *AdjacentShape) Is_empty() bool {
+	return u._variant == _adjacentShapeVariant_empty
+}
+
+func NewAdjacentShape_empty() AdjacentShape {
+	return AdjacentShape{_variant: _adjacentShapeVariant_empty}
+}
+
+func (u *AdjacentShape) Set_empty() {
+	*u = AdjacentShape{_variant: _adjacentShapeVariant_empty}
+}
+
+func Match_AdjacentShape[_R any](u *AdjacentShape, on_circle func(float64) _R, on_rectangle func([2]float64) _R, on_empty func() _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _adjacentShapeVariant_circle:
+		return on_circle(u._inner.circle)
+	case _adjacentShapeVariant_rectangle:
+		return on_rectangle(u._inner.rectangle)
+	case _adjacentShapeVariant_empty:
+		return on_empty()
+	case _adjacentShapeVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u AdjacentShape) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _adjacentShapeVariant_circle:
+		tag, value = "circle", u._inner.circle
+	case _adjacentShapeVariant_rectangle:
+		tag, value = "rectangle", u._inner.rectangle
+	case _adjacentShapeVariant_empty:
+		tag, value = "empty", u._inner.empty
+	default:
+		return nil, fmt.Errorf("cannot marshal AdjacentShape: variant %s has no JSON representation", u._variant)
+	}
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}{tag, value})
+}
+
+func (u *AdjacentShape) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal AdjacentShape: %w", err)
+	}
+	tag, payload := envelope.Type, envelope.Value
+	switch tag {
+	case "Invalid":
+		return fmt.Errorf("cannot unmarshal AdjacentShape: variant %q has no JSON representation", tag)
+	case "circle":
+		var val float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal AdjacentShape variant circle: %w", err)
+		}
+		*u = AdjacentShape{
+			_inner:   adjacentShape{circle: val},
+			_variant: _adjacentShapeVariant_circle,
+		}
+		return nil
+	case "rectangle":
+		var val [2]float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal AdjacentShape variant rectangle: %w", err)
+		}
+		*u = AdjacentShape{
+			_inner:   adjacentShape{rectangle: val},
+			_variant: _adjacentShapeVariant_rectangle,
+		}
+		return nil
+	case "empty":
+		var val struct{}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &val); err != nil {
+				return fmt.Errorf("cannot unmarshal AdjacentShape variant empty: %w", err)
+			}
+		}
+		*u = AdjacentShape{_variant: _adjacentShapeVariant_empty}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal AdjacentShape: unknown variant %q", tag)
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by gunion via `/root/.cache/go-build/d6/d67f6c57939c6db72cf9085a10056fa8980a727f77dd12d140a14968cb54910a-d/gunion --type externalShape --out-type ExternalShape --json external --no-default -o json_external_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+)
+
+type _externalShapeVariant int
+
+const (
+	_externalShapeVariant_Invalid   _externalShapeVariant = 0
+	_externalShapeVariant_circle    _externalShapeVariant = 1
+	_externalShapeVariant_rectangle _externalShapeVariant = 2
+)
+
+func (v _externalShapeVariant) String() string {
+	switch v {
+	case _externalShapeVariant_Invalid:
+		return "Invalid"
+	case _externalShapeVariant_circle:
+		return "circle"
+	case _externalShapeVariant_rectangle:
+		return "rectangle"
+	default:
+		return "unknown"
+	}
+}
+
+type ExternalShape struct {
+	_variant _externalShapeVariant
+	_inner   externalShape
+}
+
+func (u *ExternalShape) Is_Invalid() bool {
+	return u._variant == _externalShapeVariant_Invalid
+}
+
+func NewExternalShape_Invalid() ExternalShape {
+	return ExternalShape{_variant: _externalShapeVariant_Invalid}
+}
+
+func (u *ExternalShape) Set_Invalid() {
+	*u = ExternalShape{_variant: _externalShapeVariant_Invalid}
+}
+
+func (u *ExternalShape) Is_circle() bool {
+	return u._variant == _externalShapeVariant_circle
+}
+
+func (u *ExternalShape) Unwrap_circle() float64 {
+	if u._variant != _externalShapeVariant_circle {
+		panic(&gunion.WrongVariantError{Union: "ExternalShape", Want: "circle", Got: u._variant.String()})
+	}
+	return u._inner.circle
+}
+
+func (u *ExternalShape) Get_circle() (float64, bool) {
+	if u._variant == _externalShapeVariant_circle {
+		return u._inner.circle, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *ExternalShape) Try_circle() (float64, error) {
+	if u._variant != _externalShapeVariant_circle {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "ExternalShape", Want: "circle", Got: u._variant.String()}
+	}
+	return u._inner.circle, nil
+}
+
+func (u *ExternalShape) Ptr_circle() *float64 {
+	if u._variant != _externalShapeVariant_circle {
+		return nil
+	}
+	return &u._inner.circle
+}
+
+func NewExternalShape_circle(val float64) ExternalShape {
+	return ExternalShape{
+		_inner:   externalShape{circle: val},
+		_variant: _externalShapeVariant_circle,
+	}
+}
+
+func (u *ExternalShape) Set_circle(val float64) {
+	*u = ExternalShape{
+		_inner:   externalShape{circle: val},
+		_variant: _externalShapeVariant_circle,
+	}
+}
+
+func (u *ExternalShape) Is_rectangle() bool {
+	return u._variant == _externalShapeVariant_rectangle
+}
+
+func (u *ExternalShape) Unwrap_rectangle() [2]float64 {
+	if u._variant != _externalShapeVariant_rectangle {
+		panic(&gunion.WrongVariantError{Union: "ExternalShape", Want: "rectangle", Got: u._variant.String()})
+	}
+	return u._inner.rectangle
+}
+
+func (u *ExternalShape) Get_rectangle() ([2]float64, bool) {
+	if u._variant == _externalShapeVariant_rectangle {
+		return u._inner.rectangle, true
+	}
+	var zero [2]float64
+	return zero, false
+}
+
+func (u *ExternalShape) Try_rectangle() ([2]float64, error) {
+	if u._variant != _externalShapeVariant_rectangle {
+		var zero [2]float64
+		return zero, &gunion.WrongVariantError{Union: "ExternalShape", Want: "rectangle", Got: u._variant.String()}
+	}
+	return u._inner.rectangle, nil
+}
+
+func (u *ExternalShape) Ptr_rectangle() *[2]float64 {
+	if u._variant != _externalShapeVariant_rectangle {
+		return nil
+	}
+	return &u._inner.rectangle
+}
+
+func NewExternalShape_rectangle(val [2]float64) ExternalShape {
+	return ExternalShape{
+		_inner:   externalShape{rectangle: val},
+		_variant: _externalShapeVariant_rectangle,
+	}
+}
+
+func (u *ExternalShape) Set_rectangle(val [2]float64) {
+	*u = ExternalShape{
+		_inner:   externalShape{rectangle: val},
+		_variant: _externalShapeVariant_rectangle,
+	}
+}
+
+func Match_ExternalShape[_R any](u *ExternalShape, on_circle func(float64) _R, on_rectangle func([2]float64) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _externalShapeVariant_circle:
+		return on_circle(u._inner.circle)
+	case _externalShapeVariant_rectangle:
+		return on_rectangle(u._inner.rectangle)
+	case _externalShapeVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u ExternalShape) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _externalShapeVariant_circle:
+		tag, value = "circle", u._inner.circle
+	case _externalShapeVariant_rectangle:
+		tag, value = "rectangle", u._inner.rectangle
+	default:
+		return nil, fmt.Errorf("cannot marshal ExternalShape: variant %s has no JSON representation", u._variant)
+	}
+	return json.Marshal(map[string]any{tag: value})
+}
+
+func (u *ExternalShape) UnmarshalJSON(data []byte) error {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal ExternalShape: %w", err)
+	}
+	if len(envelope) != 1 {
+		return fmt.Errorf("cannot unmarshal ExternalShape: expected exactly one variant key, got %d", len(envelope))
+	}
+	var tag string
+	var payload json.RawMessage
+	for tag, payload = range envelope {
+	}
+	switch tag {
+	case "Invalid":
+		return fmt.Errorf("cannot unmarshal ExternalShape: variant %q has no JSON representation", tag)
+	case "circle":
+		var val float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal ExternalShape variant circle: %w", err)
+		}
+		*u = ExternalShape{
+			_inner:   externalShape{circle: val},
+			_variant: _externalShapeVariant_circle,
+		}
+		return nil
+	case "rectangle":
+		var val [2]float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal ExternalShape variant rectangle: %w", err)
+		}
+		*u = ExternalShape{
+			_inner:   externalShape{rectangle: val},
+			_variant: _externalShapeVariant_rectangle,
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal ExternalShape: unknown variant %q", tag)
+	}
+}
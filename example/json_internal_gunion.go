@@ -0,0 +1,218 @@
+// Code generated by gunion via `/root/.cache/go-build/d6/d67f6c57939c6db72cf9085a10056fa8980a727f77dd12d140a14968cb54910a-d/gunion --type event --out-type Event --json internal --no-default -o json_internal_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+)
+
+type _eventVariant int
+
+const (
+	_eventVariant_Invalid  _eventVariant = 0
+	_eventVariant_click    _eventVariant = 1
+	_eventVariant_keyPress _eventVariant = 2
+)
+
+func (v _eventVariant) String() string {
+	switch v {
+	case _eventVariant_Invalid:
+		return "Invalid"
+	case _eventVariant_click:
+		return "click"
+	case _eventVariant_keyPress:
+		return "keyPress"
+	default:
+		return "unknown"
+	}
+}
+
+type Event struct {
+	_variant _eventVariant
+	_inner   event
+}
+
+func (u *Event) Is_Invalid() bool {
+	return u._variant == _eventVariant_Invalid
+}
+
+func NewEvent_Invalid() Event {
+	return Event{_variant: _eventVariant_Invalid}
+}
+
+func (u *Event) Set_Invalid() {
+	*u = Event{_variant: _eventVariant_Invalid}
+}
+
+func (u *Event) Is_click() bool {
+	return u._variant == _eventVariant_click
+}
+
+func (u *Event) Unwrap_click() click {
+	if u._variant != _eventVariant_click {
+		panic(&gunion.WrongVariantError{Union: "Event", Want: "click", Got: u._variant.String()})
+	}
+	return u._inner.click
+}
+
+func (u *Event) Get_click() (click, bool) {
+	if u._variant == _eventVariant_click {
+		return u._inner.click, true
+	}
+	var zero click
+	return zero, false
+}
+
+func (u *Event) Try_click() (click, error) {
+	if u._variant != _eventVariant_click {
+		var zero click
+		return zero, &gunion.WrongVariantError{Union: "Event", Want: "click", Got: u._variant.String()}
+	}
+	return u._inner.click, nil
+}
+
+func (u *Event) Ptr_click() *click {
+	if u._variant != _eventVariant_click {
+		return nil
+	}
+	return &u._inner.click
+}
+
+func NewEvent_click(val click) Event {
+	return Event{
+		_inner:   event{click: val},
+		_variant: _eventVariant_click,
+	}
+}
+
+func (u *Event) Set_click(val click) {
+	*u = Event{
+		_inner:   event{click: val},
+		_variant: _eventVariant_click,
+	}
+}
+
+func (u *Event) Is_keyPress() bool {
+	return u._variant == _eventVariant_keyPress
+}
+
+func (u *Event) Unwrap_keyPress() keyPress {
+	if u._variant != _eventVariant_keyPress {
+		panic(&gunion.WrongVariantError{Union: "Event", Want: "keyPress", Got: u._variant.String()})
+	}
+	return u._inner.keyPress
+}
+
+func (u *Event) Get_keyPress() (keyPress, bool) {
+	if u._variant == _eventVariant_keyPress {
+		return u._inner.keyPress, true
+	}
+	var zero keyPress
+	return zero, false
+}
+
+func (u *Event) Try_keyPress() (keyPress, error) {
+	if u._variant != _eventVariant_keyPress {
+		var zero keyPress
+		return zero, &gunion.WrongVariantError{Union: "Event", Want: "keyPress", Got: u._variant.String()}
+	}
+	return u._inner.keyPress, nil
+}
+
+func (u *Event) Ptr_keyPress() *keyPress {
+	if u._variant != _eventVariant_keyPress {
+		return nil
+	}
+	return &u._inner.keyPress
+}
+
+func NewEvent_keyPress(val keyPress) Event {
+	return Event{
+		_inner:   event{keyPress: val},
+		_variant: _eventVariant_keyPress,
+	}
+}
+
+func (u *Event) Set_keyPress(val keyPress) {
+	*u = Event{
+		_inner:   event{keyPress: val},
+		_variant: _eventVariant_keyPress,
+	}
+}
+
+func Match_Event[_R any](u *Event, on_click func(click) _R, on_keyPress func(keyPress) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _eventVariant_click:
+		return on_click(u._inner.click)
+	case _eventVariant_keyPress:
+		return on_keyPress(u._inner.keyPress)
+	case _eventVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u Event) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _eventVariant_click:
+		tag, value = "click", u._inner.click
+	case _eventVariant_keyPress:
+		tag, value = "keyPress", u._inner.keyPress
+	default:
+		return nil, fmt.Errorf("cannot marshal Event: variant %s has no JSON representation", u._variant)
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil || fields == nil {
+		return nil, fmt.Errorf("cannot marshal Event: variant %s payload is not a JSON object", tag)
+	}
+	if _, ok := fields["type"]; ok {
+		return nil, fmt.Errorf("cannot marshal Event: variant %s payload already has a \"type\" key", tag)
+	}
+	fields["type"], _ = json.Marshal(tag)
+	return json.Marshal(fields)
+}
+
+func (u *Event) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal Event: %w", err)
+	}
+	tag, payload := envelope.Type, data
+	switch tag {
+	case "Invalid":
+		return fmt.Errorf("cannot unmarshal Event: variant %q has no JSON representation", tag)
+	case "click":
+		var val click
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal Event variant click: %w", err)
+		}
+		*u = Event{
+			_inner:   event{click: val},
+			_variant: _eventVariant_click,
+		}
+		return nil
+	case "keyPress":
+		var val keyPress
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal Event variant keyPress: %w", err)
+		}
+		*u = Event{
+			_inner:   event{keyPress: val},
+			_variant: _eventVariant_keyPress,
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal Event: unknown variant %q", tag)
+	}
+}
@@ -0,0 +1,100 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("externally tagged round trip", func(t *testing.T) {
+		s := NewExternalShape_circle(3.14)
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"circle": 3.14}`, string(data))
+
+		var decoded ExternalShape
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, 3.14, decoded.Unwrap_circle())
+	})
+
+	t.Run("adjacently tagged round trip", func(t *testing.T) {
+		s := NewAdjacentShape_rectangle([2]float64{3, 4})
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type": "rectangle", "value": [3, 4]}`, string(data))
+
+		var decoded AdjacentShape
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, [2]float64{3, 4}, decoded.Unwrap_rectangle())
+	})
+
+	t.Run("adjacently tagged unit variant without a value", func(t *testing.T) {
+		for _, data := range []string{`{"type": "empty"}`, `{"type": "empty", "value": null}`, `{"type": "empty", "value": {}}`} {
+			var decoded AdjacentShape
+			require.NoError(t, json.Unmarshal([]byte(data), &decoded), data)
+			assert.True(t, decoded.Is_empty(), data)
+		}
+	})
+
+	t.Run("internally tagged round trip", func(t *testing.T) {
+		e := NewEvent_click(click{X: 1, Y: 2})
+		data, err := json.Marshal(e)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type": "click", "x": 1, "y": 2}`, string(data))
+
+		var decoded Event
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, click{X: 1, Y: 2}, decoded.Unwrap_click())
+	})
+
+	t.Run("unions nested in other values", func(t *testing.T) {
+		data, err := json.Marshal([]Event{
+			NewEvent_click(click{X: 1, Y: 2}),
+			NewEvent_keyPress(keyPress{Key: "q"}),
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"type": "click", "x": 1, "y": 2}, {"type": "keyPress", "key": "q"}]`, string(data))
+
+		var decoded []Event
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Len(t, decoded, 2)
+		assert.Equal(t, "q", decoded[1].Unwrap_keyPress().Key)
+	})
+
+	t.Run("invalid variant cannot be marshaled", func(t *testing.T) {
+		_, err := json.Marshal(NewExternalShape_Invalid())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot marshal ExternalShape: variant Invalid has no JSON representation")
+	})
+
+	t.Run("unknown tag is a decode error", func(t *testing.T) {
+		var s AdjacentShape
+		err := json.Unmarshal([]byte(`{"type": "hexagon", "value": 6}`), &s)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot unmarshal AdjacentShape: unknown variant "hexagon"`)
+	})
+
+	t.Run("invalid tag is a decode error", func(t *testing.T) {
+		var s ExternalShape
+		err := json.Unmarshal([]byte(`{"Invalid": null}`), &s)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot unmarshal ExternalShape: variant "Invalid" has no JSON representation`)
+	})
+
+	t.Run("external tagging requires exactly one key", func(t *testing.T) {
+		var s ExternalShape
+		err := json.Unmarshal([]byte(`{"circle": 1, "rectangle": [1, 2]}`), &s)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected exactly one variant key, got 2")
+	})
+
+	t.Run("payload type mismatch is a decode error", func(t *testing.T) {
+		var e Event
+		err := json.Unmarshal([]byte(`{"type": "keyPress", "key": 7}`), &e)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot unmarshal Event variant keyPress")
+	})
+}
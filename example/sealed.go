@@ -0,0 +1,11 @@
+package example
+
+// A sealed union is an interface with one type per variant, so it also works with type switches.
+
+//go:generate go run .. --type token --out-type Token --sealed --no-default --switch -o sealed_gunion.go
+
+type token struct {
+	number float64
+	ident  string
+	eof    struct{}
+}
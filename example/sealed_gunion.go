@@ -0,0 +1,53 @@
+// Code generated by gunion via `/root/.cache/go-build/bd/bdeb340caf45ef1934176a93e3ccea52097ee33d97a05ce700a2f21f5d170a82-d/gunion --type token --out-type Token --sealed --no-default --switch -o sealed_gunion.go`. DO NOT EDIT.
+
+package example
+
+type Token interface {
+	isToken()
+}
+
+type TokenNumber struct {
+	Value float64
+}
+
+func (TokenNumber) isToken() {}
+
+type TokenIdent struct {
+	Value string
+}
+
+func (TokenIdent) isToken() {}
+
+type TokenEof struct{}
+
+func (TokenEof) isToken() {}
+
+func Match_Token[_R any](u Token, on_number func(float64) _R, on_ident func(string) _R, on_eof func() _R, on_Invalid func() _R) _R {
+	switch v := u.(type) {
+	case TokenNumber:
+		return on_number(v.Value)
+	case TokenIdent:
+		return on_ident(v.Value)
+	case TokenEof:
+		return on_eof()
+	case nil:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func Switch_Token(u Token, on_number func(float64), on_ident func(string), on_eof func(), on_Invalid func()) {
+	switch v := u.(type) {
+	case TokenNumber:
+		on_number(v.Value)
+	case TokenIdent:
+		on_ident(v.Value)
+	case TokenEof:
+		on_eof()
+	case nil:
+		on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
@@ -0,0 +1,53 @@
+package example
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealed(t *testing.T) {
+	describe := func(tok Token) string {
+		return Match_Token(tok,
+			func(n float64) string { return fmt.Sprintf("number %g", n) },
+			func(name string) string { return "ident " + name },
+			func() string { return "eof" },
+			func() string { return "invalid" },
+		)
+	}
+
+	t.Run("match", func(t *testing.T) {
+		assert.Equal(t, "number 1.5", describe(TokenNumber{Value: 1.5}))
+		assert.Equal(t, "ident x", describe(TokenIdent{Value: "x"}))
+		assert.Equal(t, "eof", describe(TokenEof{}))
+	})
+
+	t.Run("nil is invalid", func(t *testing.T) {
+		var tok Token
+		assert.Equal(t, "invalid", describe(tok))
+	})
+
+	t.Run("type switch", func(t *testing.T) {
+		var tok Token = TokenIdent{Value: "y"}
+		switch v := tok.(type) {
+		case TokenIdent:
+			assert.Equal(t, "y", v.Value)
+		default:
+			t.Fatalf("unexpected variant %T", v)
+		}
+	})
+
+	t.Run("switch", func(t *testing.T) {
+		var got []string
+		for _, tok := range []Token{TokenNumber{Value: 2}, TokenEof{}} {
+			Switch_Token(tok,
+				func(float64) { got = append(got, "number") },
+				func(string) { got = append(got, "ident") },
+				func() { got = append(got, "eof") },
+				func() { got = append(got, "invalid") },
+			)
+		}
+		assert.Equal(t, []string{"number", "eof"}, got)
+	})
+}
@@ -3,7 +3,7 @@
 // Run `go generate ./...` from the repository root to regenerate the union type.
 package example
 
-//go:generate go run .. --type shape --no-default
+//go:generate go run .. --type shape --no-default --visitor --match-or --match-e --switch --kind --equal --format
 
 type shape struct {
 	circle    float64
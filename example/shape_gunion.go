@@ -1,7 +1,13 @@
-// Code generated by gunion via `/home/sid/.cache/go-build/95/95ef01e92306c8cd1466caa907c6db0435c16918242227ae7320a0598f475c9b-d/gunion --type shape --no-default`. DO NOT EDIT.
+// Code generated by gunion via `/home/sid/.cache/go-build/95/95ef01e92306c8cd1466caa907c6db0435c16918242227ae7320a0598f475c9b-d/gunion --type shape --no-default --visitor --match-or --match-e --switch --kind --equal --format`. DO NOT EDIT.
 
 package example
 
+import (
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+	"io"
+)
+
 type _shapeVariant int
 
 const (
@@ -39,13 +45,17 @@ func NewShapeUnion_Invalid() ShapeUnion {
 	return ShapeUnion{_variant: _shapeVariant_Invalid}
 }
 
+func (u *ShapeUnion) Set_Invalid() {
+	*u = ShapeUnion{_variant: _shapeVariant_Invalid}
+}
+
 func (u *ShapeUnion) Is_circle() bool {
 	return u._variant == _shapeVariant_circle
 }
 
 func (u *ShapeUnion) Unwrap_circle() float64 {
 	if u._variant != _shapeVariant_circle {
-		panic("called Unwrap_circle on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "ShapeUnion", Want: "circle", Got: u._variant.String()})
 	}
 	return u._inner.circle
 }
@@ -58,6 +68,21 @@ func (u *ShapeUnion) Get_circle() (float64, bool) {
 	return zero, false
 }
 
+func (u *ShapeUnion) Try_circle() (float64, error) {
+	if u._variant != _shapeVariant_circle {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "ShapeUnion", Want: "circle", Got: u._variant.String()}
+	}
+	return u._inner.circle, nil
+}
+
+func (u *ShapeUnion) Ptr_circle() *float64 {
+	if u._variant != _shapeVariant_circle {
+		return nil
+	}
+	return &u._inner.circle
+}
+
 func NewShapeUnion_circle(val float64) ShapeUnion {
 	return ShapeUnion{
 		_inner:   shape{circle: val},
@@ -65,13 +90,20 @@ func NewShapeUnion_circle(val float64) ShapeUnion {
 	}
 }
 
+func (u *ShapeUnion) Set_circle(val float64) {
+	*u = ShapeUnion{
+		_inner:   shape{circle: val},
+		_variant: _shapeVariant_circle,
+	}
+}
+
 func (u *ShapeUnion) Is_rectangle() bool {
 	return u._variant == _shapeVariant_rectangle
 }
 
 func (u *ShapeUnion) Unwrap_rectangle() [2]float64 {
 	if u._variant != _shapeVariant_rectangle {
-		panic("called Unwrap_rectangle on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "ShapeUnion", Want: "rectangle", Got: u._variant.String()})
 	}
 	return u._inner.rectangle
 }
@@ -84,6 +116,21 @@ func (u *ShapeUnion) Get_rectangle() ([2]float64, bool) {
 	return zero, false
 }
 
+func (u *ShapeUnion) Try_rectangle() ([2]float64, error) {
+	if u._variant != _shapeVariant_rectangle {
+		var zero [2]float64
+		return zero, &gunion.WrongVariantError{Union: "ShapeUnion", Want: "rectangle", Got: u._variant.String()}
+	}
+	return u._inner.rectangle, nil
+}
+
+func (u *ShapeUnion) Ptr_rectangle() *[2]float64 {
+	if u._variant != _shapeVariant_rectangle {
+		return nil
+	}
+	return &u._inner.rectangle
+}
+
 func NewShapeUnion_rectangle(val [2]float64) ShapeUnion {
 	return ShapeUnion{
 		_inner:   shape{rectangle: val},
@@ -91,13 +138,20 @@ func NewShapeUnion_rectangle(val [2]float64) ShapeUnion {
 	}
 }
 
+func (u *ShapeUnion) Set_rectangle(val [2]float64) {
+	*u = ShapeUnion{
+		_inner:   shape{rectangle: val},
+		_variant: _shapeVariant_rectangle,
+	}
+}
+
 func (u *ShapeUnion) Is_triangle() bool {
 	return u._variant == _shapeVariant_triangle
 }
 
 func (u *ShapeUnion) Unwrap_triangle() [3]float64 {
 	if u._variant != _shapeVariant_triangle {
-		panic("called Unwrap_triangle on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "ShapeUnion", Want: "triangle", Got: u._variant.String()})
 	}
 	return u._inner.triangle
 }
@@ -110,6 +164,21 @@ func (u *ShapeUnion) Get_triangle() ([3]float64, bool) {
 	return zero, false
 }
 
+func (u *ShapeUnion) Try_triangle() ([3]float64, error) {
+	if u._variant != _shapeVariant_triangle {
+		var zero [3]float64
+		return zero, &gunion.WrongVariantError{Union: "ShapeUnion", Want: "triangle", Got: u._variant.String()}
+	}
+	return u._inner.triangle, nil
+}
+
+func (u *ShapeUnion) Ptr_triangle() *[3]float64 {
+	if u._variant != _shapeVariant_triangle {
+		return nil
+	}
+	return &u._inner.triangle
+}
+
 func NewShapeUnion_triangle(val [3]float64) ShapeUnion {
 	return ShapeUnion{
 		_inner:   shape{triangle: val},
@@ -117,6 +186,13 @@ func NewShapeUnion_triangle(val [3]float64) ShapeUnion {
 	}
 }
 
+func (u *ShapeUnion) Set_triangle(val [3]float64) {
+	*u = ShapeUnion{
+		_inner:   shape{triangle: val},
+		_variant: _shapeVariant_triangle,
+	}
+}
+
 func Match_ShapeUnion[_R any](u *ShapeUnion, on_circle func(float64) _R, on_rectangle func([2]float64) _R, on_triangle func([3]float64) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _shapeVariant_circle:
@@ -131,3 +207,165 @@ func Match_ShapeUnion[_R any](u *ShapeUnion, on_circle func(float64) _R, on_rect
 		panic("unreachable")
 	}
 }
+
+func MatchE_ShapeUnion[_R any](u *ShapeUnion, on_circle func(float64) (_R, error), on_rectangle func([2]float64) (_R, error), on_triangle func([3]float64) (_R, error), on_Invalid func() (_R, error)) (_R, error) {
+	switch u._variant {
+	case _shapeVariant_circle:
+		return on_circle(u._inner.circle)
+	case _shapeVariant_rectangle:
+		return on_rectangle(u._inner.rectangle)
+	case _shapeVariant_triangle:
+		return on_triangle(u._inner.triangle)
+	case _shapeVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func Switch_ShapeUnion(u *ShapeUnion, on_circle func(float64), on_rectangle func([2]float64), on_triangle func([3]float64), on_Invalid func()) {
+	switch u._variant {
+	case _shapeVariant_circle:
+		on_circle(u._inner.circle)
+	case _shapeVariant_rectangle:
+		on_rectangle(u._inner.rectangle)
+	case _shapeVariant_triangle:
+		on_triangle(u._inner.triangle)
+	case _shapeVariant_Invalid:
+		on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+type ShapeUnionArms[_R any] struct {
+	On_circle    func(float64) _R
+	On_rectangle func([2]float64) _R
+	On_triangle  func([3]float64) _R
+	On_Invalid   func() _R
+}
+
+func MatchOr_ShapeUnion[_R any](u *ShapeUnion, arms ShapeUnionArms[_R], fallback func() _R) _R {
+	switch u._variant {
+	case _shapeVariant_circle:
+		if arms.On_circle != nil {
+			return arms.On_circle(u._inner.circle)
+		}
+	case _shapeVariant_rectangle:
+		if arms.On_rectangle != nil {
+			return arms.On_rectangle(u._inner.rectangle)
+		}
+	case _shapeVariant_triangle:
+		if arms.On_triangle != nil {
+			return arms.On_triangle(u._inner.triangle)
+		}
+	case _shapeVariant_Invalid:
+		if arms.On_Invalid != nil {
+			return arms.On_Invalid()
+		}
+	}
+	return fallback()
+}
+
+type ShapeUnionVisitor[_R any] interface {
+	Visit_circle(float64) _R
+	Visit_rectangle([2]float64) _R
+	Visit_triangle([3]float64) _R
+	Visit_Invalid() _R
+}
+
+func Accept_ShapeUnion[_R any](u *ShapeUnion, v ShapeUnionVisitor[_R]) _R {
+	switch u._variant {
+	case _shapeVariant_circle:
+		return v.Visit_circle(u._inner.circle)
+	case _shapeVariant_rectangle:
+		return v.Visit_rectangle(u._inner.rectangle)
+	case _shapeVariant_triangle:
+		return v.Visit_triangle(u._inner.triangle)
+	case _shapeVariant_Invalid:
+		return v.Visit_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+type ShapeUnionKind int
+
+const (
+	ShapeUnionKindInvalid   ShapeUnionKind = 0
+	ShapeUnionKindCircle    ShapeUnionKind = 1
+	ShapeUnionKindRectangle ShapeUnionKind = 2
+	ShapeUnionKindTriangle  ShapeUnionKind = 3
+)
+
+func (k ShapeUnionKind) String() string {
+	return _shapeVariant(k).String()
+}
+
+func (u *ShapeUnion) Kind() ShapeUnionKind {
+	return ShapeUnionKind(u._variant)
+}
+
+func Variants_ShapeUnion() []ShapeUnionKind {
+	return []ShapeUnionKind{ShapeUnionKindCircle, ShapeUnionKindRectangle, ShapeUnionKindTriangle}
+}
+
+func (u *ShapeUnion) Equal(other *ShapeUnion) bool {
+	if u._variant != other._variant {
+		return false
+	}
+	switch u._variant {
+	case _shapeVariant_circle:
+		return u._inner.circle == other._inner.circle
+	case _shapeVariant_rectangle:
+		return u._inner.rectangle == other._inner.rectangle
+	case _shapeVariant_triangle:
+		return u._inner.triangle == other._inner.triangle
+	default:
+		return true
+	}
+}
+
+func (u ShapeUnion) String() string {
+	return fmt.Sprint(u)
+}
+
+func (u ShapeUnion) GoString() string {
+	switch u._variant {
+	case _shapeVariant_Invalid:
+		return "example.NewShapeUnion_Invalid()"
+	case _shapeVariant_circle:
+		return fmt.Sprintf("example.NewShapeUnion_circle(%#v)", u._inner.circle)
+	case _shapeVariant_rectangle:
+		return fmt.Sprintf("example.NewShapeUnion_rectangle(%#v)", u._inner.rectangle)
+	case _shapeVariant_triangle:
+		return fmt.Sprintf("example.NewShapeUnion_triangle(%#v)", u._inner.triangle)
+	default:
+		return fmt.Sprintf("example.ShapeUnion(%s)", u._variant)
+	}
+}
+
+func (u ShapeUnion) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, u.GoString())
+		return
+	}
+	if verb != 'v' && verb != 's' {
+		fmt.Fprintf(f, "%%!%c(ShapeUnion=%s)", verb, u.String())
+		return
+	}
+	format := "%v"
+	if f.Flag('+') {
+		format = "%+v"
+	}
+	switch u._variant {
+	case _shapeVariant_circle:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "ShapeUnion", "circle", u._inner.circle)
+	case _shapeVariant_rectangle:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "ShapeUnion", "rectangle", u._inner.rectangle)
+	case _shapeVariant_triangle:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "ShapeUnion", "triangle", u._inner.triangle)
+	default:
+		fmt.Fprintf(f, "ShapeUnion(%s)", u._variant)
+	}
+}
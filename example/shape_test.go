@@ -1,9 +1,14 @@
 package example
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/sidkurella/gunion/gunion"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestShapeUnion(t *testing.T) {
@@ -35,7 +40,7 @@ func TestShapeUnion(t *testing.T) {
 
 	t.Run("unwrap panics for wrong variant", func(t *testing.T) {
 		circle := NewShapeUnion_circle(3.14)
-		assert.Panics(t, func() {
+		assert.PanicsWithError(t, "ShapeUnion holds variant circle, not rectangle", func() {
 			circle.Unwrap_rectangle()
 		})
 	})
@@ -54,6 +59,42 @@ func TestShapeUnion(t *testing.T) {
 		assert.Equal(t, [2]float64{}, val)
 	})
 
+	t.Run("try returns value and nil for correct variant", func(t *testing.T) {
+		circle := NewShapeUnion_circle(3.14)
+		val, err := circle.Try_circle()
+		assert.NoError(t, err)
+		assert.Equal(t, 3.14, val)
+	})
+
+	t.Run("try returns a wrong variant error for wrong variant", func(t *testing.T) {
+		circle := NewShapeUnion_circle(3.14)
+		val, err := circle.Try_rectangle()
+		assert.Equal(t, [2]float64{}, val)
+
+		var wrongVariant *gunion.WrongVariantError
+		require.ErrorAs(t, err, &wrongVariant)
+		assert.Equal(t, gunion.WrongVariantError{Union: "ShapeUnion", Want: "rectangle", Got: "circle"}, *wrongVariant)
+	})
+
+	t.Run("ptr updates the active payload in place", func(t *testing.T) {
+		tri := NewShapeUnion_triangle([3]float64{3, 4, 5})
+		tri.Ptr_triangle()[2] = 6
+		assert.Equal(t, [3]float64{3, 4, 6}, tri.Unwrap_triangle())
+		assert.Nil(t, tri.Ptr_circle())
+	})
+
+	t.Run("set switches the variant and clears the old payload", func(t *testing.T) {
+		s := NewShapeUnion_rectangle([2]float64{3, 4})
+		s.Set_circle(1.5)
+		assert.True(t, s.Is_circle())
+		assert.Equal(t, 1.5, s.Unwrap_circle())
+		assert.Equal(t, [2]float64{}, s._inner.rectangle)
+
+		s.Set_Invalid()
+		assert.True(t, s.Is_Invalid())
+		assert.Equal(t, 0.0, s._inner.circle)
+	})
+
 	t.Run("match is exhaustive", func(t *testing.T) {
 		circle := NewShapeUnion_circle(3.14)
 		result := Match_ShapeUnion(
@@ -66,6 +107,115 @@ func TestShapeUnion(t *testing.T) {
 		assert.Equal(t, "circle", result)
 	})
 
+	t.Run("match-e returns the arm's error", func(t *testing.T) {
+		perimeter := func(s ShapeUnion) (float64, error) {
+			return MatchE_ShapeUnion(
+				&s,
+				func(radius float64) (float64, error) { return 2 * math.Pi * radius, nil },
+				func(dims [2]float64) (float64, error) { return 2 * (dims[0] + dims[1]), nil },
+				func(sides [3]float64) (float64, error) { return sides[0] + sides[1] + sides[2], nil },
+				func() (float64, error) { return 0, errors.New("invalid shape") },
+			)
+		}
+
+		p, err := perimeter(NewShapeUnion_rectangle([2]float64{3.0, 4.0}))
+		assert.NoError(t, err)
+		assert.Equal(t, 14.0, p)
+
+		_, err = perimeter(NewShapeUnion_Invalid())
+		assert.EqualError(t, err, "invalid shape")
+	})
+
+	t.Run("switch calls the arm for the active variant", func(t *testing.T) {
+		var calls []string
+		tri := NewShapeUnion_triangle([3]float64{3.0, 4.0, 5.0})
+		Switch_ShapeUnion(
+			&tri,
+			func(radius float64) { calls = append(calls, "circle") },
+			func(dims [2]float64) { calls = append(calls, "rectangle") },
+			func(sides [3]float64) { calls = append(calls, "triangle") },
+			func() { calls = append(calls, "invalid") },
+		)
+		assert.Equal(t, []string{"triangle"}, calls)
+	})
+
+	t.Run("match-or calls the given arm or the fallback", func(t *testing.T) {
+		arms := ShapeUnionArms[string]{
+			On_circle: func(radius float64) string { return "round" },
+		}
+		fallback := func() string { return "pointy" }
+
+		circle := NewShapeUnion_circle(3.14)
+		assert.Equal(t, "round", MatchOr_ShapeUnion(&circle, arms, fallback))
+
+		rect := NewShapeUnion_rectangle([2]float64{3.0, 4.0})
+		assert.Equal(t, "pointy", MatchOr_ShapeUnion(&rect, arms, fallback))
+
+		var invalid ShapeUnion
+		assert.Equal(t, "pointy", MatchOr_ShapeUnion(&invalid, arms, fallback))
+	})
+
+	t.Run("accept calls the visitor method for the active variant", func(t *testing.T) {
+		v := areaVisitor{}
+		circle := NewShapeUnion_circle(1)
+		assert.InDelta(t, math.Pi, Accept_ShapeUnion(&circle, v), 1e-9)
+
+		rect := NewShapeUnion_rectangle([2]float64{3.0, 4.0})
+		assert.Equal(t, 12.0, Accept_ShapeUnion(&rect, v))
+
+		tri := NewShapeUnion_triangle([3]float64{3.0, 4.0, 5.0})
+		assert.Equal(t, 6.0, Accept_ShapeUnion(&tri, v))
+
+		var invalid ShapeUnion
+		assert.Equal(t, 0.0, Accept_ShapeUnion(&invalid, v))
+	})
+
+	t.Run("kind reports the active variant", func(t *testing.T) {
+		circle := NewShapeUnion_circle(3.14)
+		assert.Equal(t, ShapeUnionKindCircle, circle.Kind())
+		assert.Equal(t, "circle", circle.Kind().String())
+
+		var invalid ShapeUnion
+		assert.Equal(t, ShapeUnionKindInvalid, invalid.Kind())
+	})
+
+	t.Run("variants lists kinds in declaration order", func(t *testing.T) {
+		assert.Equal(t, []ShapeUnionKind{
+			ShapeUnionKindCircle,
+			ShapeUnionKindRectangle,
+			ShapeUnionKindTriangle,
+		}, Variants_ShapeUnion())
+	})
+
+	t.Run("equal compares the variant and active payload", func(t *testing.T) {
+		a := NewShapeUnion_circle(1)
+		b := NewShapeUnion_circle(1)
+		c := NewShapeUnion_circle(2)
+		d := NewShapeUnion_rectangle([2]float64{1, 1})
+		assert.True(t, a.Equal(&b))
+		assert.False(t, a.Equal(&c))
+		assert.False(t, a.Equal(&d))
+
+		var x, y ShapeUnion
+		assert.True(t, x.Equal(&y))
+	})
+
+	t.Run("format prints the active variant", func(t *testing.T) {
+		circle := NewShapeUnion_circle(3.14)
+		assert.Equal(t, "ShapeUnion(circle: 3.14)", circle.String())
+		assert.Equal(t, "ShapeUnion(circle: 3.14)", fmt.Sprintf("%v", &circle))
+		assert.Equal(t, "example.NewShapeUnion_circle(3.14)", fmt.Sprintf("%#v", circle))
+
+		rect := NewShapeUnion_rectangle([2]float64{3.0, 4.0})
+		assert.Equal(t, "ShapeUnion(rectangle: [3 4])", fmt.Sprintf("%+v", rect))
+		assert.Equal(t, "example.NewShapeUnion_rectangle([2]float64{3, 4})", rect.GoString())
+
+		var invalid ShapeUnion
+		assert.Equal(t, "ShapeUnion(Invalid)", invalid.String())
+		assert.Equal(t, "example.NewShapeUnion_Invalid()", fmt.Sprintf("%#v", invalid))
+		assert.Equal(t, "%!d(ShapeUnion=ShapeUnion(Invalid))", fmt.Sprintf("%d", invalid))
+	})
+
 	t.Run("zero value is invalid variant", func(t *testing.T) {
 		var s ShapeUnion
 		assert.True(t, s.Is_Invalid())
@@ -79,3 +229,18 @@ func TestShapeUnion(t *testing.T) {
 		assert.Equal(t, "triangle", _shapeVariant_triangle.String())
 	})
 }
+
+// areaVisitor implements ShapeUnionVisitor by computing the area of each shape.
+type areaVisitor struct{}
+
+func (areaVisitor) Visit_circle(radius float64) float64 { return math.Pi * radius * radius }
+
+func (areaVisitor) Visit_rectangle(dims [2]float64) float64 { return dims[0] * dims[1] }
+
+// Visit_triangle uses Heron's formula.
+func (areaVisitor) Visit_triangle(sides [3]float64) float64 {
+	s := (sides[0] + sides[1] + sides[2]) / 2
+	return math.Sqrt(s * (s - sides[0]) * (s - sides[1]) * (s - sides[2]))
+}
+
+func (areaVisitor) Visit_Invalid() float64 { return 0 }
@@ -0,0 +1,22 @@
+package example
+
+import "log/slog"
+
+// With --slog, a logged union only shows its active variant, and payloads can redact themselves.
+
+//go:generate go run .. --type authEvent --out-type AuthEvent --slog --no-default -o slog_gunion.go
+
+type authEvent struct {
+	login  credentials
+	logout struct{ user string }
+}
+
+// credentials keeps its password out of logs.
+type credentials struct {
+	user     string
+	password string
+}
+
+func (c credentials) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("user", c.user), slog.String("password", "REDACTED"))
+}
@@ -0,0 +1,184 @@
+// Code generated by gunion via `/root/.cache/go-build/75/75ce0ad3abe0df70a855855b7514449a02cd14eb9918e28463facdb0ceecde2d-d/gunion --type authEvent --out-type AuthEvent --slog --no-default -o slog_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	gunion "github.com/sidkurella/gunion/gunion"
+	"log/slog"
+)
+
+type _authEventVariant int
+
+const (
+	_authEventVariant_Invalid _authEventVariant = 0
+	_authEventVariant_login   _authEventVariant = 1
+	_authEventVariant_logout  _authEventVariant = 2
+)
+
+func (v _authEventVariant) String() string {
+	switch v {
+	case _authEventVariant_Invalid:
+		return "Invalid"
+	case _authEventVariant_login:
+		return "login"
+	case _authEventVariant_logout:
+		return "logout"
+	default:
+		return "unknown"
+	}
+}
+
+type AuthEvent struct {
+	_variant _authEventVariant
+	_inner   authEvent
+}
+
+func (u *AuthEvent) Is_Invalid() bool {
+	return u._variant == _authEventVariant_Invalid
+}
+
+func NewAuthEvent_Invalid() AuthEvent {
+	return AuthEvent{_variant: _authEventVariant_Invalid}
+}
+
+func (u *AuthEvent) Set_Invalid() {
+	*u = AuthEvent{_variant: _authEventVariant_Invalid}
+}
+
+func (u *AuthEvent) Is_login() bool {
+	return u._variant == _authEventVariant_login
+}
+
+func (u *AuthEvent) Unwrap_login() credentials {
+	if u._variant != _authEventVariant_login {
+		panic(&gunion.WrongVariantError{Union: "AuthEvent", Want: "login", Got: u._variant.String()})
+	}
+	return u._inner.login
+}
+
+func (u *AuthEvent) Get_login() (credentials, bool) {
+	if u._variant == _authEventVariant_login {
+		return u._inner.login, true
+	}
+	var zero credentials
+	return zero, false
+}
+
+func (u *AuthEvent) Try_login() (credentials, error) {
+	if u._variant != _authEventVariant_login {
+		var zero credentials
+		return zero, &gunion.WrongVariantError{Union: "AuthEvent", Want: "login", Got: u._variant.String()}
+	}
+	return u._inner.login, nil
+}
+
+func (u *AuthEvent) Ptr_login() *credentials {
+	if u._variant != _authEventVariant_login {
+		return nil
+	}
+	return &u._inner.login
+}
+
+func NewAuthEvent_login(val credentials) AuthEvent {
+	return AuthEvent{
+		_inner:   authEvent{login: val},
+		_variant: _authEventVariant_login,
+	}
+}
+
+func (u *AuthEvent) Set_login(val credentials) {
+	*u = AuthEvent{
+		_inner:   authEvent{login: val},
+		_variant: _authEventVariant_login,
+	}
+}
+
+func (u *AuthEvent) Is_logout() bool {
+	return u._variant == _authEventVariant_logout
+}
+
+func (u *AuthEvent) Unwrap_logout() struct {
+	user string
+} {
+	if u._variant != _authEventVariant_logout {
+		panic(&gunion.WrongVariantError{Union: "AuthEvent", Want: "logout", Got: u._variant.String()})
+	}
+	return u._inner.logout
+}
+
+func (u *AuthEvent) Get_logout() (struct {
+	user string
+}, bool) {
+	if u._variant == _authEventVariant_logout {
+		return u._inner.logout, true
+	}
+	var zero struct {
+		user string
+	}
+	return zero, false
+}
+
+func (u *AuthEvent) Try_logout() (struct {
+	user string
+}, error) {
+	if u._variant != _authEventVariant_logout {
+		var zero struct {
+			user string
+		}
+		return zero, &gunion.WrongVariantError{Union: "AuthEvent", Want: "logout", Got: u._variant.String()}
+	}
+	return u._inner.logout, nil
+}
+
+func (u *AuthEvent) Ptr_logout() *struct {
+	user string
+} {
+	if u._variant != _authEventVariant_logout {
+		return nil
+	}
+	return &u._inner.logout
+}
+
+func NewAuthEvent_logout(user string) AuthEvent {
+	return AuthEvent{
+		_inner: authEvent{logout: struct {
+			user string
+		}{user: user}},
+		_variant: _authEventVariant_logout,
+	}
+}
+
+func (u *AuthEvent) Set_logout(val struct {
+	user string
+}) {
+	*u = AuthEvent{
+		_inner:   authEvent{logout: val},
+		_variant: _authEventVariant_logout,
+	}
+}
+
+func Match_AuthEvent[_R any](u *AuthEvent, on_login func(credentials) _R, on_logout func(string) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _authEventVariant_login:
+		return on_login(u._inner.login)
+	case _authEventVariant_logout:
+		return on_logout(u._inner.logout.user)
+	case _authEventVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u AuthEvent) LogValue() slog.Value {
+	switch u._variant {
+	case _authEventVariant_login:
+		return slog.GroupValue(slog.String("variant", "login"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.login).Resolve()})
+	case _authEventVariant_logout:
+		return slog.GroupValue(slog.String("variant", "logout"), slog.Attr{Key: "value", Value: slog.GroupValue(
+			slog.Attr{Key: "user", Value: slog.AnyValue(u._inner.logout.user).Resolve()},
+		)})
+	default:
+		return slog.GroupValue(slog.String("variant", u._variant.String()))
+	}
+}
@@ -0,0 +1,46 @@
+package example
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logged := func(event AuthEvent) string {
+		buf.Reset()
+		logger.Info("auth", "event", event)
+		return buf.String()
+	}
+
+	t.Run("payloads are resolved", func(t *testing.T) {
+		event := NewAuthEvent_login(credentials{user: "sid", password: "hunter2"})
+		assert.Equal(t,
+			"level=INFO msg=auth event.variant=login event.value.user=sid event.value.password=REDACTED\n",
+			logged(event),
+		)
+	})
+
+	t.Run("records are logged as groups", func(t *testing.T) {
+		assert.Equal(t,
+			"level=INFO msg=auth event.variant=logout event.value.user=sid\n",
+			logged(NewAuthEvent_logout("sid")),
+		)
+	})
+
+	t.Run("invalid has no value", func(t *testing.T) {
+		var event AuthEvent
+		assert.Equal(t, "level=INFO msg=auth event.variant=Invalid\n", logged(event))
+	})
+}
@@ -0,0 +1,27 @@
+package example
+
+// With --sql, unions can be stored with database/sql. The two unions below have the same variants.
+// PaymentJSON is stored in one JSON column, and PaymentColumns in a discriminator column and a
+// payload column.
+
+//go:generate go run .. --type jsonPayment --out-type PaymentJSON --no-default --json adjacent --sql json -o sql_json_gunion.go
+
+type jsonPayment struct {
+	card struct {
+		last4  string
+		expiry string
+	}
+	cash    float64
+	pending struct{}
+}
+
+//go:generate go run .. --type columnsPayment --out-type PaymentColumns --no-default --sql columns -o sql_columns_gunion.go
+
+type columnsPayment struct {
+	card struct {
+		last4  string
+		expiry string
+	}
+	cash    float64
+	pending struct{}
+}
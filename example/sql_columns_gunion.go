@@ -0,0 +1,308 @@
+// Code generated by gunion via `/root/.cache/go-build/ac/ac10f9c966e70089375930a795b1deb3f478908fd7f9294b79799565ed7f92c4-d/gunion --type columnsPayment --out-type PaymentColumns --no-default --sql columns -o sql_columns_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+)
+
+type _columnsPaymentVariant int
+
+const (
+	_columnsPaymentVariant_Invalid _columnsPaymentVariant = 0
+	_columnsPaymentVariant_card    _columnsPaymentVariant = 1
+	_columnsPaymentVariant_cash    _columnsPaymentVariant = 2
+	_columnsPaymentVariant_pending _columnsPaymentVariant = 3
+)
+
+func (v _columnsPaymentVariant) String() string {
+	switch v {
+	case _columnsPaymentVariant_Invalid:
+		return "Invalid"
+	case _columnsPaymentVariant_card:
+		return "card"
+	case _columnsPaymentVariant_cash:
+		return "cash"
+	case _columnsPaymentVariant_pending:
+		return "pending"
+	default:
+		return "unknown"
+	}
+}
+
+type PaymentColumns struct {
+	_variant _columnsPaymentVariant
+	_inner   columnsPayment
+}
+
+func (u *PaymentColumns) Is_Invalid() bool {
+	return u._variant == _columnsPaymentVariant_Invalid
+}
+
+func NewPaymentColumns_Invalid() PaymentColumns {
+	return PaymentColumns{_variant: _columnsPaymentVariant_Invalid}
+}
+
+func (u *PaymentColumns) Set_Invalid() {
+	*u = PaymentColumns{_variant: _columnsPaymentVariant_Invalid}
+}
+
+func (u *PaymentColumns) Is_card() bool {
+	return u._variant == _columnsPaymentVariant_card
+}
+
+func (u *PaymentColumns) Unwrap_card() struct {
+	last4  string
+	expiry string
+} {
+	if u._variant != _columnsPaymentVariant_card {
+		panic(&gunion.WrongVariantError{Union: "PaymentColumns", Want: "card", Got: u._variant.String()})
+	}
+	return u._inner.card
+}
+
+func (u *PaymentColumns) Get_card() (struct {
+	last4  string
+	expiry string
+}, bool) {
+	if u._variant == _columnsPaymentVariant_card {
+		return u._inner.card, true
+	}
+	var zero struct {
+		last4  string
+		expiry string
+	}
+	return zero, false
+}
+
+func (u *PaymentColumns) Try_card() (struct {
+	last4  string
+	expiry string
+}, error) {
+	if u._variant != _columnsPaymentVariant_card {
+		var zero struct {
+			last4  string
+			expiry string
+		}
+		return zero, &gunion.WrongVariantError{Union: "PaymentColumns", Want: "card", Got: u._variant.String()}
+	}
+	return u._inner.card, nil
+}
+
+func (u *PaymentColumns) Ptr_card() *struct {
+	last4  string
+	expiry string
+} {
+	if u._variant != _columnsPaymentVariant_card {
+		return nil
+	}
+	return &u._inner.card
+}
+
+func NewPaymentColumns_card(last4 string, expiry string) PaymentColumns {
+	return PaymentColumns{
+		_inner: columnsPayment{card: struct {
+			last4  string
+			expiry string
+		}{last4: last4, expiry: expiry}},
+		_variant: _columnsPaymentVariant_card,
+	}
+}
+
+func (u *PaymentColumns) Set_card(val struct {
+	last4  string
+	expiry string
+}) {
+	*u = PaymentColumns{
+		_inner:   columnsPayment{card: val},
+		_variant: _columnsPaymentVariant_card,
+	}
+}
+
+func (u *PaymentColumns) Is_cash() bool {
+	return u._variant == _columnsPaymentVariant_cash
+}
+
+func (u *PaymentColumns) Unwrap_cash() float64 {
+	if u._variant != _columnsPaymentVariant_cash {
+		panic(&gunion.WrongVariantError{Union: "PaymentColumns", Want: "cash", Got: u._variant.String()})
+	}
+	return u._inner.cash
+}
+
+func (u *PaymentColumns) Get_cash() (float64, bool) {
+	if u._variant == _columnsPaymentVariant_cash {
+		return u._inner.cash, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *PaymentColumns) Try_cash() (float64, error) {
+	if u._variant != _columnsPaymentVariant_cash {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "PaymentColumns", Want: "cash", Got: u._variant.String()}
+	}
+	return u._inner.cash, nil
+}
+
+func (u *PaymentColumns) Ptr_cash() *float64 {
+	if u._variant != _columnsPaymentVariant_cash {
+		return nil
+	}
+	return &u._inner.cash
+}
+
+func NewPaymentColumns_cash(val float64) PaymentColumns {
+	return PaymentColumns{
+		_inner:   columnsPayment{cash: val},
+		_variant: _columnsPaymentVariant_cash,
+	}
+}
+
+func (u *PaymentColumns) Set_cash(val float64) {
+	*u = PaymentColumns{
+		_inner:   columnsPayment{cash: val},
+		_variant: _columnsPaymentVariant_cash,
+	}
+}
+
+func (u *PaymentColumns) Is_pending() bool {
+	return u._variant == _columnsPaymentVariant_pending
+}
+
+func NewPaymentColumns_pending() PaymentColumns {
+	return PaymentColumns{_variant: _columnsPaymentVariant_pending}
+}
+
+func (u *PaymentColumns) Set_pending() {
+	*u = PaymentColumns{_variant: _columnsPaymentVariant_pending}
+}
+
+func Match_PaymentColumns[_R any](u *PaymentColumns, on_card func(string, string) _R, on_cash func(float64) _R, on_pending func() _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _columnsPaymentVariant_card:
+		return on_card(u._inner.card.last4, u._inner.card.expiry)
+	case _columnsPaymentVariant_cash:
+		return on_cash(u._inner.cash)
+	case _columnsPaymentVariant_pending:
+		return on_pending()
+	case _columnsPaymentVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u PaymentColumns) Value() (driver.Value, error) {
+	switch u._variant {
+	case _columnsPaymentVariant_Invalid:
+		return nil, nil
+	case _columnsPaymentVariant_card:
+		return "card", nil
+	case _columnsPaymentVariant_cash:
+		return "cash", nil
+	case _columnsPaymentVariant_pending:
+		return "pending", nil
+	default:
+		return nil, fmt.Errorf("cannot store PaymentColumns: unknown variant %s", u._variant)
+	}
+}
+
+func (u *PaymentColumns) Scan(src any) error {
+	var tag string
+	switch src := src.(type) {
+	case nil:
+		*u = PaymentColumns{}
+		return nil
+	case string:
+		tag = src
+	case []byte:
+		tag = string(src)
+	default:
+		return fmt.Errorf("cannot scan %T into PaymentColumns", src)
+	}
+	switch tag {
+	case "card":
+		*u = PaymentColumns{_variant: _columnsPaymentVariant_card}
+	case "cash":
+		*u = PaymentColumns{_variant: _columnsPaymentVariant_cash}
+	case "pending":
+		*u = PaymentColumns{_variant: _columnsPaymentVariant_pending}
+	default:
+		return fmt.Errorf("cannot scan PaymentColumns: unknown variant %q", tag)
+	}
+	return nil
+}
+
+// PaymentColumnsPayload stores the active payload of a PaymentColumns as JSON, in the column after its variant.
+type PaymentColumnsPayload struct {
+	u *PaymentColumns
+}
+
+func (u *PaymentColumns) Payload() PaymentColumnsPayload {
+	return PaymentColumnsPayload{u: u}
+}
+
+func (p PaymentColumnsPayload) Value() (driver.Value, error) {
+	var value any
+	switch p.u._variant {
+	case _columnsPaymentVariant_card:
+		value = struct {
+			Last4  string `json:"last4"`
+			Expiry string `json:"expiry"`
+		}{p.u._inner.card.last4, p.u._inner.card.expiry}
+	case _columnsPaymentVariant_cash:
+		value = p.u._inner.cash
+	default:
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot store PaymentColumns payload: %w", err)
+	}
+	return string(data), nil
+}
+
+func (p PaymentColumnsPayload) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+	case string:
+		data = []byte(src)
+	case []byte:
+		data = src
+	default:
+		return fmt.Errorf("cannot scan %T into PaymentColumns payload", src)
+	}
+	switch p.u._variant {
+	case _columnsPaymentVariant_card:
+		var val struct {
+			Last4  string `json:"last4"`
+			Expiry string `json:"expiry"`
+		}
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan PaymentColumns variant card: %w", err)
+		}
+		*p.u = PaymentColumns{
+			_inner: columnsPayment{card: struct {
+				last4  string
+				expiry string
+			}{val.Last4, val.Expiry}},
+			_variant: _columnsPaymentVariant_card,
+		}
+	case _columnsPaymentVariant_cash:
+		var val float64
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan PaymentColumns variant cash: %w", err)
+		}
+		*p.u = PaymentColumns{
+			_inner:   columnsPayment{cash: val},
+			_variant: _columnsPaymentVariant_cash,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+// Code generated by gunion via `/root/.cache/go-build/ac/ac10f9c966e70089375930a795b1deb3f478908fd7f9294b79799565ed7f92c4-d/gunion --type jsonPayment --out-type PaymentJSON --no-default --json adjacent --sql json -o sql_json_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+)
+
+type _jsonPaymentVariant int
+
+const (
+	_jsonPaymentVariant_Invalid _jsonPaymentVariant = 0
+	_jsonPaymentVariant_card    _jsonPaymentVariant = 1
+	_jsonPaymentVariant_cash    _jsonPaymentVariant = 2
+	_jsonPaymentVariant_pending _jsonPaymentVariant = 3
+)
+
+func (v _jsonPaymentVariant) String() string {
+	switch v {
+	case _jsonPaymentVariant_Invalid:
+		return "Invalid"
+	case _jsonPaymentVariant_card:
+		return "card"
+	case _jsonPaymentVariant_cash:
+		return "cash"
+	case _jsonPaymentVariant_pending:
+		return "pending"
+	default:
+		return "unknown"
+	}
+}
+
+type PaymentJSON struct {
+	_variant _jsonPaymentVariant
+	_inner   jsonPayment
+}
+
+func (u *PaymentJSON) Is_Invalid() bool {
+	return u._variant == _jsonPaymentVariant_Invalid
+}
+
+func NewPaymentJSON_Invalid() PaymentJSON {
+	return PaymentJSON{_variant: _jsonPaymentVariant_Invalid}
+}
+
+func (u *PaymentJSON) Set_Invalid() {
+	*u = PaymentJSON{_variant: _jsonPaymentVariant_Invalid}
+}
+
+func (u *PaymentJSON) Is_card() bool {
+	return u._variant == _jsonPaymentVariant_card
+}
+
+func (u *PaymentJSON) Unwrap_card() struct {
+	last4  string
+	expiry string
+} {
+	if u._variant != _jsonPaymentVariant_card {
+		panic(&gunion.WrongVariantError{Union: "PaymentJSON", Want: "card", Got: u._variant.String()})
+	}
+	return u._inner.card
+}
+
+func (u *PaymentJSON) Get_card() (struct {
+	last4  string
+	expiry string
+}, bool) {
+	if u._variant == _jsonPaymentVariant_card {
+		return u._inner.card, true
+	}
+	var zero struct {
+		last4  string
+		expiry string
+	}
+	return zero, false
+}
+
+func (u *PaymentJSON) Try_card() (struct {
+	last4  string
+	expiry string
+}, error) {
+	if u._variant != _jsonPaymentVariant_card {
+		var zero struct {
+			last4  string
+			expiry string
+		}
+		return zero, &gunion.WrongVariantError{Union: "PaymentJSON", Want: "card", Got: u._variant.String()}
+	}
+	return u._inner.card, nil
+}
+
+func (u *PaymentJSON) Ptr_card() *struct {
+	last4  string
+	expiry string
+} {
+	if u._variant != _jsonPaymentVariant_card {
+		return nil
+	}
+	return &u._inner.card
+}
+
+func NewPaymentJSON_card(last4 string, expiry string) PaymentJSON {
+	return PaymentJSON{
+		_inner: jsonPayment{card: struct {
+			last4  string
+			expiry string
+		}{last4: last4, expiry: expiry}},
+		_variant: _jsonPaymentVariant_card,
+	}
+}
+
+func (u *PaymentJSON) Set_card(val struct {
+	last4  string
+	expiry string
+}) {
+	*u = PaymentJSON{
+		_inner:   jsonPayment{card: val},
+		_variant: _jsonPaymentVariant_card,
+	}
+}
+
+func (u *PaymentJSON) Is_cash() bool {
+	return u._variant == _jsonPaymentVariant_cash
+}
+
+func (u *PaymentJSON) Unwrap_cash() float64 {
+	if u._variant != _jsonPaymentVariant_cash {
+		panic(&gunion.WrongVariantError{Union: "PaymentJSON", Want: "cash", Got: u._variant.String()})
+	}
+	return u._inner.cash
+}
+
+func (u *PaymentJSON) Get_cash() (float64, bool) {
+	if u._variant == _jsonPaymentVariant_cash {
+		return u._inner.cash, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *PaymentJSON) Try_cash() (float64, error) {
+	if u._variant != _jsonPaymentVariant_cash {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "PaymentJSON", Want: "cash", Got: u._variant.String()}
+	}
+	return u._inner.cash, nil
+}
+
+func (u *PaymentJSON) Ptr_cash() *float64 {
+	if u._variant != _jsonPaymentVariant_cash {
+		return nil
+	}
+	return &u._inner.cash
+}
+
+func NewPaymentJSON_cash(val float64) PaymentJSON {
+	return PaymentJSON{
+		_inner:   jsonPayment{cash: val},
+		_variant: _jsonPaymentVariant_cash,
+	}
+}
+
+func (u *PaymentJSON) Set_cash(val float64) {
+	*u = PaymentJSON{
+		_inner:   jsonPayment{cash: val},
+		_variant: _jsonPaymentVariant_cash,
+	}
+}
+
+func (u *PaymentJSON) Is_pending() bool {
+	return u._variant == _jsonPaymentVariant_pending
+}
+
+func NewPaymentJSON_pending() PaymentJSON {
+	return PaymentJSON{_variant: _jsonPaymentVariant_pending}
+}
+
+func (u *PaymentJSON) Set_pending() {
+	*u = PaymentJSON{_variant: _jsonPaymentVariant_pending}
+}
+
+func Match_PaymentJSON[_R any](u *PaymentJSON, on_card func(string, string) _R, on_cash func(float64) _R, on_pending func() _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _jsonPaymentVariant_card:
+		return on_card(u._inner.card.last4, u._inner.card.expiry)
+	case _jsonPaymentVariant_cash:
+		return on_cash(u._inner.cash)
+	case _jsonPaymentVariant_pending:
+		return on_pending()
+	case _jsonPaymentVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u PaymentJSON) Value() (driver.Value, error) {
+	if u._variant == _jsonPaymentVariant_Invalid {
+		return nil, nil
+	}
+	data, err := u.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (u *PaymentJSON) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*u = PaymentJSON{}
+		return nil
+	case string:
+		return u.UnmarshalJSON([]byte(src))
+	case []byte:
+		return u.UnmarshalJSON(src)
+	default:
+		return fmt.Errorf("cannot scan %T into PaymentJSON", src)
+	}
+}
+
+func (u PaymentJSON) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _jsonPaymentVariant_card:
+		tag, value = "card", struct {
+			Last4  string `json:"last4"`
+			Expiry string `json:"expiry"`
+		}{u._inner.card.last4, u._inner.card.expiry}
+	case _jsonPaymentVariant_cash:
+		tag, value = "cash", u._inner.cash
+	case _jsonPaymentVariant_pending:
+		tag, value = "pending", u._inner.pending
+	default:
+		return nil, fmt.Errorf("cannot marshal PaymentJSON: variant %s has no JSON representation", u._variant)
+	}
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}{tag, value})
+}
+
+func (u *PaymentJSON) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal PaymentJSON: %w", err)
+	}
+	tag, payload := envelope.Type, envelope.Value
+	switch tag {
+	case "Invalid":
+		return fmt.Errorf("cannot unmarshal PaymentJSON: variant %q has no JSON representation", tag)
+	case "card":
+		var val struct {
+			Last4  string `json:"last4"`
+			Expiry string `json:"expiry"`
+		}
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal PaymentJSON variant card: %w", err)
+		}
+		*u = PaymentJSON{
+			_inner: jsonPayment{card: struct {
+				last4  string
+				expiry string
+			}{val.Last4, val.Expiry}},
+			_variant: _jsonPaymentVariant_card,
+		}
+		return nil
+	case "cash":
+		var val float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal PaymentJSON variant cash: %w", err)
+		}
+		*u = PaymentJSON{
+			_inner:   jsonPayment{cash: val},
+			_variant: _jsonPaymentVariant_cash,
+		}
+		return nil
+	case "pending":
+		var val struct{}
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal PaymentJSON variant pending: %w", err)
+		}
+		*u = PaymentJSON{_variant: _jsonPaymentVariant_pending}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal PaymentJSON: unknown variant %q", tag)
+	}
+}
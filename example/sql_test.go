@@ -0,0 +1,160 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQL(t *testing.T) {
+	t.Run("json column", func(t *testing.T) {
+		db := sql.OpenDB(&memDB{})
+		payments := []PaymentJSON{
+			NewPaymentJSON_card("4242", "12/30"),
+			NewPaymentJSON_cash(9.5),
+			NewPaymentJSON_pending(),
+			{},
+		}
+		for _, p := range payments {
+			_, err := db.Exec("INSERT INTO payments VALUES (?)", p)
+			require.NoError(t, err)
+		}
+
+		rows, err := db.Query("SELECT payment FROM payments")
+		require.NoError(t, err)
+		defer rows.Close()
+		var got []PaymentJSON
+		for rows.Next() {
+			var p PaymentJSON
+			require.NoError(t, rows.Scan(&p))
+			got = append(got, p)
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, payments, got)
+	})
+
+	t.Run("stored json", func(t *testing.T) {
+		value, err := NewPaymentJSON_cash(9.5).Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{"type":"cash","value":9.5}`, value)
+
+		value, err = PaymentJSON{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("discriminator and payload columns", func(t *testing.T) {
+		db := sql.OpenDB(&memDB{})
+		payments := []PaymentColumns{
+			NewPaymentColumns_card("4242", "12/30"),
+			NewPaymentColumns_cash(9.5),
+			NewPaymentColumns_pending(),
+			{},
+		}
+		for _, p := range payments {
+			_, err := db.Exec("INSERT INTO payments VALUES (?, ?)", p, p.Payload())
+			require.NoError(t, err)
+		}
+
+		rows, err := db.Query("SELECT kind, payload FROM payments")
+		require.NoError(t, err)
+		defer rows.Close()
+		var got []PaymentColumns
+		for rows.Next() {
+			var p PaymentColumns
+			require.NoError(t, rows.Scan(&p, p.Payload()))
+			got = append(got, p)
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, payments, got)
+	})
+
+	t.Run("stored columns", func(t *testing.T) {
+		p := NewPaymentColumns_card("4242", "12/30")
+		kind, err := p.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "card", kind)
+		payload, err := p.Payload().Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{"last4":"4242","expiry":"12/30"}`, payload)
+
+		pending := NewPaymentColumns_pending()
+		payload, err = pending.Payload().Value()
+		require.NoError(t, err)
+		assert.Nil(t, payload)
+	})
+
+	t.Run("unknown variant", func(t *testing.T) {
+		var p PaymentColumns
+		assert.EqualError(t, p.Scan("cheque"), `cannot scan PaymentColumns: unknown variant "cheque"`)
+	})
+}
+
+// memDB is an in-memory stand-in for a database with a single table. Every statement but SELECT
+// appends its arguments as a row, and SELECT returns all rows.
+type memDB struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (db *memDB) Connect(context.Context) (driver.Conn, error) { return memConn{db}, nil }
+
+func (db *memDB) Driver() driver.Driver { return nil }
+
+type memConn struct{ db *memDB }
+
+func (c memConn) Prepare(query string) (driver.Stmt, error) { return memStmt{c.db, query}, nil }
+
+func (c memConn) Close() error { return nil }
+
+func (c memConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions are not supported") }
+
+type memStmt struct {
+	db    *memDB
+	query string
+}
+
+func (s memStmt) Close() error { return nil }
+
+func (s memStmt) NumInput() int { return -1 }
+
+func (s memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	s.db.rows = append(s.db.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s memStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	// The selected columns are listed between SELECT and FROM.
+	columns, _, _ := strings.Cut(strings.TrimPrefix(s.query, "SELECT "), " FROM")
+	return &memRows{columns: strings.Split(columns, ", "), rows: s.db.rows}, nil
+}
+
+type memRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *memRows) Columns() []string { return r.columns }
+
+func (r *memRows) Close() error { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
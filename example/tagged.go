@@ -0,0 +1,10 @@
+package example
+
+// Variant names set with gunion:"name=..." are printed as-is, even when they contain verbs like %.
+
+//go:generate go run .. --type reading --out-type Reading --format -o tagged_gunion.go
+
+type reading struct {
+	humidity    float64 `gunion:"name=humidity %"`
+	temperature float64 `gunion:"name=temperature %d"`
+}
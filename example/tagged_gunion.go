@@ -0,0 +1,177 @@
+// Code generated by gunion via `/root/.cache/go-build/05/0550f3fc5aec50ad20380703c1ec086970b880c41b390ab256e189dccb832e1c-d/gunion --type reading --out-type Reading --format -o tagged_gunion.go`. DO NOT EDIT.
+
+package example
+
+import (
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+	"io"
+)
+
+type _readingVariant int
+
+const (
+	_readingVariant_humidity    _readingVariant = 0
+	_readingVariant_temperature _readingVariant = 1
+)
+
+func (v _readingVariant) String() string {
+	switch v {
+	case _readingVariant_humidity:
+		return "humidity %"
+	case _readingVariant_temperature:
+		return "temperature %d"
+	default:
+		return "unknown"
+	}
+}
+
+type Reading struct {
+	_variant _readingVariant
+	_inner   reading
+}
+
+func (u *Reading) Is_humidity() bool {
+	return u._variant == _readingVariant_humidity
+}
+
+func (u *Reading) Unwrap_humidity() float64 {
+	if u._variant != _readingVariant_humidity {
+		panic(&gunion.WrongVariantError{Union: "Reading", Want: "humidity %", Got: u._variant.String()})
+	}
+	return u._inner.humidity
+}
+
+func (u *Reading) Get_humidity() (float64, bool) {
+	if u._variant == _readingVariant_humidity {
+		return u._inner.humidity, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *Reading) Try_humidity() (float64, error) {
+	if u._variant != _readingVariant_humidity {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "Reading", Want: "humidity %", Got: u._variant.String()}
+	}
+	return u._inner.humidity, nil
+}
+
+func (u *Reading) Ptr_humidity() *float64 {
+	if u._variant != _readingVariant_humidity {
+		return nil
+	}
+	return &u._inner.humidity
+}
+
+func NewReading_humidity(val float64) Reading {
+	return Reading{
+		_inner:   reading{humidity: val},
+		_variant: _readingVariant_humidity,
+	}
+}
+
+func (u *Reading) Set_humidity(val float64) {
+	*u = Reading{
+		_inner:   reading{humidity: val},
+		_variant: _readingVariant_humidity,
+	}
+}
+
+func (u *Reading) Is_temperature() bool {
+	return u._variant == _readingVariant_temperature
+}
+
+func (u *Reading) Unwrap_temperature() float64 {
+	if u._variant != _readingVariant_temperature {
+		panic(&gunion.WrongVariantError{Union: "Reading", Want: "temperature %d", Got: u._variant.String()})
+	}
+	return u._inner.temperature
+}
+
+func (u *Reading) Get_temperature() (float64, bool) {
+	if u._variant == _readingVariant_temperature {
+		return u._inner.temperature, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *Reading) Try_temperature() (float64, error) {
+	if u._variant != _readingVariant_temperature {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "Reading", Want: "temperature %d", Got: u._variant.String()}
+	}
+	return u._inner.temperature, nil
+}
+
+func (u *Reading) Ptr_temperature() *float64 {
+	if u._variant != _readingVariant_temperature {
+		return nil
+	}
+	return &u._inner.temperature
+}
+
+func NewReading_temperature(val float64) Reading {
+	return Reading{
+		_inner:   reading{temperature: val},
+		_variant: _readingVariant_temperature,
+	}
+}
+
+func (u *Reading) Set_temperature(val float64) {
+	*u = Reading{
+		_inner:   reading{temperature: val},
+		_variant: _readingVariant_temperature,
+	}
+}
+
+func Match_Reading[_R any](u *Reading, on_humidity func(float64) _R, on_temperature func(float64) _R) _R {
+	switch u._variant {
+	case _readingVariant_humidity:
+		return on_humidity(u._inner.humidity)
+	case _readingVariant_temperature:
+		return on_temperature(u._inner.temperature)
+	default:
+		panic("unreachable")
+	}
+}
+
+func (u Reading) String() string {
+	return fmt.Sprint(u)
+}
+
+func (u Reading) GoString() string {
+	switch u._variant {
+	case _readingVariant_humidity:
+		return fmt.Sprintf("example.NewReading_humidity(%#v)", u._inner.humidity)
+	case _readingVariant_temperature:
+		return fmt.Sprintf("example.NewReading_temperature(%#v)", u._inner.temperature)
+	default:
+		return fmt.Sprintf("example.Reading(%s)", u._variant)
+	}
+}
+
+func (u Reading) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, u.GoString())
+		return
+	}
+	if verb != 'v' && verb != 's' {
+		fmt.Fprintf(f, "%%!%c(Reading=%s)", verb, u.String())
+		return
+	}
+	format := "%v"
+	if f.Flag('+') {
+		format = "%+v"
+	}
+	switch u._variant {
+	case _readingVariant_humidity:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "Reading", "humidity %", u._inner.humidity)
+	case _readingVariant_temperature:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "Reading", "temperature %d", u._inner.temperature)
+	default:
+		fmt.Fprintf(f, "Reading(%s)", u._variant)
+	}
+}
@@ -0,0 +1,14 @@
+package example
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaggedNames(t *testing.T) {
+	humidity := NewReading_humidity(40.5)
+	assert.Equal(t, "Reading(humidity %: 40.5)", humidity.String())
+	assert.Equal(t, "Reading(temperature %d: 21)", fmt.Sprintf("%+v", NewReading_temperature(21)))
+}
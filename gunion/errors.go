@@ -0,0 +1,19 @@
+// Package gunion is the runtime support imported by generated unions.
+package gunion
+
+import "fmt"
+
+// WrongVariantError reports an access to a variant of a union that holds another variant. Unwrap
+// methods panic with it, and Try methods return it.
+type WrongVariantError struct {
+	// Union is the name of the union type, such as ShapeUnion.
+	Union string
+	// Want is the variant that was accessed.
+	Want string
+	// Got is the active variant.
+	Got string
+}
+
+func (e *WrongVariantError) Error() string {
+	return fmt.Sprintf("%s holds variant %s, not %s", e.Union, e.Got, e.Want)
+}
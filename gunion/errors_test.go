@@ -0,0 +1,20 @@
+package gunion_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sidkurella/gunion/gunion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrongVariantError(t *testing.T) {
+	err := fmt.Errorf("reading shape: %w", &gunion.WrongVariantError{Union: "ShapeUnion", Want: "circle", Got: "rectangle"})
+	assert.EqualError(t, err, "reading shape: ShapeUnion holds variant rectangle, not circle")
+
+	var wrongVariant *gunion.WrongVariantError
+	require.True(t, errors.As(err, &wrongVariant))
+	assert.Equal(t, "rectangle", wrongVariant.Got)
+}
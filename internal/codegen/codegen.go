@@ -1,7 +1,12 @@
 package codegen
 
 import (
+	"bytes"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/sidkurella/gunion/internal/config"
@@ -11,20 +16,206 @@ import (
 const preambleTemplate string = "// Code generated by gunion%s. DO NOT EDIT.\n\n"
 
 const variantNameTemplate = `_%sVariant`
-const isVariantNameTemplate = `Is_%s`
-const unwrapVariantNameTemplate = `Unwrap_%s`
-const getVariantNameTemplate = `Get_%s`
-const constructorNameTemplate = `New%s_%s`
-const matchFuncNameTemplate = `Match_%s`
-const matchArmNameTemplate = `on_%s`
+const innerTypeNameTemplate = `_%sInner`
+const valueFuncNameTemplate = `_%sValue`
+const boxFuncNameTemplate = `_%sBox`
+
+// runtimePkgPath is the import path of the runtime package used by generated code.
+const runtimePkgPath = "github.com/sidkurella/gunion/gunion"
 
 type variant struct {
 	name      string
 	constName string
+	// Enum value of this variant.
+	value int
 	// The field on the inner struct this variant corresponds to. Nil for the Invalid variant.
 	field *types.Field
 	// jen.Code representation of the field type. Nil for the Invalid variant.
 	typeCode *jen.Statement
+	// Whether this is a unit variant, whose field is an empty struct{} and carries no data.
+	unit bool
+	// Fields of a record variant, whose field is a non-empty anonymous struct. Nil for other variants.
+	record []recordField
+	// Options from the field's gunion struct tag. Zero for the Invalid variant.
+	opts fieldOptions
+}
+
+// displayName returns the name String() returns for the variant.
+func (v variant) displayName() string {
+	if v.opts.name != "" {
+		return v.opts.name
+	}
+	return v.name
+}
+
+// wireTag returns the tag identifying the variant in JSON and SQL.
+func (v variant) wireTag() string {
+	if v.opts.json != "" {
+		return v.opts.json
+	}
+	return v.name
+}
+
+// addDeprecation marks the next declaration in outFile deprecated if the variant is.
+func addDeprecation(v variant, outFile *jen.File) {
+	if comment, ok := deprecationComment(v); ok {
+		outFile.Comment(comment)
+	}
+}
+
+// deprecationComment returns the doc comment marking the variant's generated API deprecated,
+// if it is.
+func deprecationComment(v variant) (string, bool) {
+	if !v.opts.deprecated {
+		return "", false
+	}
+	if v.opts.deprecation != "" {
+		return "Deprecated: " + v.opts.deprecation, true
+	}
+	return fmt.Sprintf("Deprecated: variant %s is deprecated.", v.name), true
+}
+
+// recordField is a field of a record variant's anonymous struct.
+type recordField struct {
+	name     string
+	typeCode *jen.Statement
+}
+
+// hasPayload reports whether the variant carries a value, which is false for Invalid and unit variants.
+// Constructors and match arms for variants without a payload take no arguments.
+func (v variant) hasPayload() bool {
+	return v.field != nil && !v.unit
+}
+
+// argTypes returns the types of the arguments passed to the variant's match arms: the payload type,
+// or each field type of a record variant.
+func (v variant) argTypes() []jen.Code {
+	if v.record == nil {
+		return []jen.Code{v.typeCode}
+	}
+	ret := make([]jen.Code, len(v.record))
+	for i, f := range v.record {
+		ret[i] = f.typeCode
+	}
+	return ret
+}
+
+// isUnitType reports whether typ is the empty struct{}.
+func isUnitType(typ types.Type) bool {
+	s, ok := typ.(types.Struct)
+	return ok && len(s.Fields) == 0
+}
+
+// recordFields returns the fields of typ if it is a non-empty anonymous struct whose fields can all be
+// used as parameter names, or nil otherwise.
+func recordFields(typ types.Type) ([]recordField, error) {
+	s, ok := typ.(types.Struct)
+	if !ok || len(s.Fields) == 0 {
+		return nil, nil
+	}
+	for _, f := range s.Fields {
+		if f.Var.Name == "_" {
+			return nil, nil
+		}
+	}
+
+	fields := make([]recordField, len(s.Fields))
+	for i, f := range s.Fields {
+		code, err := typeToCode(f.Var.Type)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = recordField{name: f.Var.Name, typeCode: code}
+	}
+	return fields, nil
+}
+
+// recordParams returns the constructor parameter names of a record variant. They are the field names,
+// except that a parameter named like an identifier the constructor refers to, such as time in
+// struct{ time time.Time }, would shadow it; those get leading underscores.
+func recordParams(v variant, outType string, sf *structFields, gi *genericsInfo) []string {
+	referenced := map[string]bool{outType: true, sf.innerTypeName: true, v.constName: true}
+	if sf.innerTypePkg != "" {
+		referenced[packageIdent(sf.innerTypePkg)] = true
+	}
+	for _, name := range gi.typeParamNames {
+		referenced[name] = true
+	}
+	typeIdents(v.field.Var.Type, referenced)
+
+	taken := maps.Clone(referenced)
+	for _, f := range v.record {
+		taken[f.name] = true
+	}
+	params := make([]string, len(v.record))
+	for i, f := range v.record {
+		params[i] = f.name
+		if referenced[f.name] {
+			params[i] = uniqueName(f.name, taken)
+			taken[params[i]] = true
+		}
+	}
+	return params
+}
+
+// typeIdents adds the identifiers that typ is written with to idents: type names, and the names
+// packages are imported as.
+func typeIdents(typ types.Type, idents map[string]bool) {
+	switch t := typ.(type) {
+	case types.Basic:
+		idents[t.Name] = true
+	case types.Named:
+		idents[t.Name] = true
+		if t.Package != "" {
+			idents[packageIdent(t.Package)] = true
+		}
+		for _, arg := range t.TypeArgs {
+			typeIdents(arg, idents)
+		}
+	case types.Pointer:
+		typeIdents(t.Elem, idents)
+	case types.Slice:
+		typeIdents(t.Elem, idents)
+	case types.Array:
+		typeIdents(t.Elem, idents)
+	case types.Chan:
+		typeIdents(t.Elem, idents)
+	case types.Map:
+		typeIdents(t.Key, idents)
+		typeIdents(t.Value, idents)
+	case types.Struct:
+		for _, f := range t.Fields {
+			typeIdents(f.Var.Type, idents)
+		}
+	case types.Signature:
+		for _, param := range slices.Concat(t.Params, t.Returns) {
+			typeIdents(param.Type, idents)
+		}
+	case types.Interface:
+		for _, embed := range t.Embeds {
+			typeIdents(embed, idents)
+		}
+		for _, method := range t.Methods {
+			typeIdents(method.Signature, idents)
+		}
+	case types.Union:
+		for _, member := range t.Members {
+			typeIdents(member.Type, idents)
+		}
+	}
+}
+
+// packageIdent returns the name a package is imported as in generated code, the same way jen guesses it:
+// the last element of its path, lowercased and without other characters than letters and digits.
+func packageIdent(path string) string {
+	name := strings.ToLower(path[strings.LastIndex(path, "/")+1:])
+	name = strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return -1
+	}, name)
+	return strings.TrimLeft(name, "0123456789")
 }
 
 // structFields holds the generated field names for the union struct.
@@ -32,12 +223,25 @@ type variant struct {
 type structFields struct {
 	variantField string // field name for the variant tag (default "_variant")
 	innerField   string // field name for the embedded source struct (default "_inner")
+	valueField   string // field name for the payload in the compact layout (default "_value")
 	invalidName  string // variant name for the invalid/zero-value variant (default "Invalid")
+
+	innerTypePkg  string // package path of the inner field's type
+	innerTypeName string // name of the inner field's type: the source type, or its copy in another package
+
+	// Whether the union uses the compact layout, storing a pointer to the payload as an any in
+	// valueField instead of embedding the source struct.
+	compact bool
+	// Names of the functions reading and storing a payload of the compact layout.
+	valueFunc string
+	boxFunc   string
+	// Names of the generated methods and functions, following the naming scheme.
+	names naming
 }
 
 // newStructFields picks field names for the generated union struct that don't
-// collide with any of the source struct's field names. Starts with "_variant"
-// and "_inner", prepending underscores until unique.
+// collide with any of the source struct's field names. Starts with "_variant",
+// "_inner" and "_value", prepending underscores until unique.
 func newStructFields(sourceFields []types.Field) structFields {
 	taken := make(map[string]bool, len(sourceFields))
 	for _, f := range sourceFields {
@@ -46,6 +250,7 @@ func newStructFields(sourceFields []types.Field) structFields {
 	return structFields{
 		variantField: uniqueName("_variant", taken),
 		innerField:   uniqueName("_inner", taken),
+		valueField:   uniqueName("_value", taken),
 		invalidName:  uniqueName("Invalid", taken),
 	}
 }
@@ -59,6 +264,68 @@ func uniqueName(candidate string, taken map[string]bool) string {
 	return candidate
 }
 
+// fieldAccess builds the access path to a variant's field on the given receiver: u._inner.<Name>,
+// or _myUnionValue[<Type>](u._value) in the compact layout.
+func (sf *structFields) fieldAccess(recv string, v variant) *jen.Statement {
+	if sf.compact {
+		return jen.Id(sf.valueFunc).Types(v.typeCode).Call(jen.Id(recv).Dot(sf.valueField))
+	}
+	return jen.Id(recv).Dot(sf.innerField).Dot(v.name)
+}
+
+// innerType builds the type of the inner field: myUnion or myUnion[T, U].
+func (sf *structFields) innerType(gi *genericsInfo) *jen.Statement {
+	stmt := jen.Qual(sf.innerTypePkg, sf.innerTypeName)
+	if len(gi.typeArgs) > 0 {
+		stmt = stmt.Types(gi.typeArgs...)
+	}
+	return stmt
+}
+
+// payloadArgs builds the arguments passed to the variant's match arms: u._inner.<Name>, or
+// u._inner.<Name>.<Field> for each field of a record variant.
+func (sf *structFields) payloadArgs(recv string, v variant) []jen.Code {
+	if v.record == nil {
+		return []jen.Code{sf.fieldAccess(recv, v)}
+	}
+	args := make([]jen.Code, len(v.record))
+	for i, f := range v.record {
+		args[i] = sf.fieldAccess(recv, v).Dot(f.name)
+	}
+	return args
+}
+
+// unionLiteral builds a union value holding the given variant. val is the payload expression,
+// and is ignored for variants without a payload.
+//
+//	OutType[T, U]{_variant: <constName>, _inner: myUnion[T, U]{<Variant>: val}}
+//
+// In the compact layout, the payload is stored behind a pointer:
+//
+//	OutType[T, U]{_variant: <constName>, _value: _myUnionBox[<Type>](val)}
+func unionLiteral(
+	v variant, outType string, sf *structFields, gi *genericsInfo, val jen.Code,
+) *jen.Statement {
+	if !v.hasPayload() {
+		return gi.returnType(outType).Values(jen.Dict{
+			jen.Id(sf.variantField): jen.Id(v.constName),
+		})
+	}
+
+	if sf.compact {
+		return gi.returnType(outType).Values(jen.Dict{
+			jen.Id(sf.variantField): jen.Id(v.constName),
+			jen.Id(sf.valueField):   jen.Id(sf.boxFunc).Types(v.typeCode).Call(val),
+		})
+	}
+	return gi.returnType(outType).Values(jen.Dict{
+		jen.Id(sf.variantField): jen.Id(v.constName),
+		jen.Id(sf.innerField): sf.innerType(gi).Values(jen.Dict{
+			jen.Id(v.name): val,
+		}),
+	})
+}
+
 // genericsInfo holds pre-computed jen code for generic type parameters.
 // All fields are nil/empty when the source type is not generic.
 type genericsInfo struct {
@@ -70,6 +337,8 @@ type genericsInfo struct {
 	typeArgs []jen.Code
 	// The name chosen for Match's result type parameter, guaranteed unique.
 	matchResultParam string
+	// Type parameter names, e.g. T, U.
+	typeParamNames []string
 }
 
 // newGenericsInfo builds genericsInfo from the source type's type parameters.
@@ -90,6 +359,7 @@ func newGenericsInfo(typeParams []types.TypeParam) (genericsInfo, error) {
 		}
 		info.typeParamDefs = append(info.typeParamDefs, jen.Id(tp.Name).Add(constraint))
 		info.typeArgs = append(info.typeArgs, jen.Id(tp.Name))
+		info.typeParamNames = append(info.typeParamNames, tp.Name)
 	}
 
 	// Pick a unique name for Match's result type param.
@@ -102,6 +372,29 @@ func newGenericsInfo(typeParams []types.TypeParam) (genericsInfo, error) {
 	return info, nil
 }
 
+// resultParam returns the name of the result type parameter for Match and similar functions.
+func (g *genericsInfo) resultParam() string {
+	if g.matchResultParam == "" {
+		return "_R"
+	}
+	return g.matchResultParam
+}
+
+// resultTypeParams builds the type parameter definitions of the source type followed by
+// the result type parameter, e.g. [T any, U comparable, _R any].
+func (g *genericsInfo) resultTypeParams() []jen.Code {
+	params := make([]jen.Code, 0, len(g.typeParamDefs)+1)
+	params = append(params, g.typeParamDefs...)
+	return append(params, jen.Id(g.resultParam()).Any())
+}
+
+// resultTypeArgs builds the type arguments matching resultTypeParams, e.g. [T, U, _R].
+func (g *genericsInfo) resultTypeArgs() []jen.Code {
+	args := make([]jen.Code, 0, len(g.typeArgs)+1)
+	args = append(args, g.typeArgs...)
+	return append(args, jen.Id(g.resultParam()))
+}
+
 // receiverType builds the receiver expression: *OutType or *OutType[T, U].
 func (g *genericsInfo) receiverType(outType string) *jen.Statement {
 	stmt := jen.Id("u").Op("*").Id(outType)
@@ -111,6 +404,12 @@ func (g *genericsInfo) receiverType(outType string) *jen.Statement {
 	return stmt
 }
 
+// valueReceiverType builds a value receiver expression: OutType or OutType[T, U].
+// Used for methods that must be callable on non-addressable union values, such as MarshalJSON.
+func (g *genericsInfo) valueReceiverType(outType string) *jen.Statement {
+	return jen.Id("u").Add(g.returnType(outType))
+}
+
 // returnType builds the return type expression: OutType or OutType[T, U].
 func (g *genericsInfo) returnType(outType string) *jen.Statement {
 	stmt := jen.Id(outType)
@@ -121,16 +420,118 @@ func (g *genericsInfo) returnType(outType string) *jen.Statement {
 }
 
 type CodeGenerator struct {
-	config config.OutputConfig
+	configs []config.OutputConfig
 }
 
-func NewCodeGenerator(c config.OutputConfig) *CodeGenerator {
+// NewCodeGenerator creates a generator for one union per config.
+// Unions whose configs share an output file are written to that file together.
+func NewCodeGenerator(configs ...config.OutputConfig) *CodeGenerator {
 	return &CodeGenerator{
-		config: c,
+		configs: configs,
+	}
+}
+
+// File is the formatted source of one generated file.
+type File struct {
+	Path    string
+	Content []byte
+}
+
+// outputFile is a generated file that has not been rendered yet.
+type outputFile struct {
+	path string
+	pkg  string
+	file *jen.File
+}
+
+// Generate generates a union from each type, using the config at the same position.
+// Nothing is written unless every union generates successfully.
+func (c *CodeGenerator) Generate(ts ...types.Named) error {
+	files, err := c.Render(ts...)
+	if err != nil {
+		return err
+	}
+
+	// Write the generated code to the output files.
+	for _, f := range files {
+		err = os.WriteFile(f.Path, f.Content, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to save generated code: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Render generates the same files as Generate, but returns them instead of writing them.
+func (c *CodeGenerator) Render(ts ...types.Named) ([]File, error) {
+	files, err := c.render(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]File, len(files))
+	for i, f := range files {
+		buf := &bytes.Buffer{}
+		if err := f.file.Render(buf); err != nil {
+			return nil, fmt.Errorf("failed to render generated code for %s: %w", f.path, err)
+		}
+		ret[i] = File{Path: f.path, Content: buf.Bytes()}
+	}
+	return ret, nil
+}
+
+// render generates every union into its output file, in order of first appearance.
+func (c *CodeGenerator) render(ts []types.Named) ([]outputFile, error) {
+	if len(ts) != len(c.configs) {
+		return nil, fmt.Errorf("got %d types for %d output configs", len(ts), len(c.configs))
+	}
+
+	var files []outputFile
+	byPath := make(map[string]int)
+	for i, t := range ts {
+		cfg := c.configs[i]
+		idx, ok := byPath[cfg.OutFile]
+		if !ok {
+			idx = len(files)
+			byPath[cfg.OutFile] = idx
+			files = append(files, outputFile{path: cfg.OutFile, pkg: cfg.OutPkg, file: newOutputFile(cfg, t)})
+		}
+		f := files[idx]
+		if f.pkg != cfg.OutPkg {
+			return nil, fmt.Errorf(
+				"unions written to %s must share an output package, got %s and %s", cfg.OutFile, f.pkg, cfg.OutPkg,
+			)
+		}
+
+		if err := generateUnion(cfg, t, f.file); err != nil {
+			return nil, fmt.Errorf("failed to generate union for type %s: %w", t.Name, err)
+		}
+	}
+	return files, nil
+}
+
+// outPkgPath returns the import path of the package the union generated from t is written to.
+func outPkgPath(cfg config.OutputConfig, t types.Named) string {
+	if cfg.OutPkgPath == "" {
+		return t.Package
+	}
+	return cfg.OutPkgPath
+}
+
+// newOutputFile creates an empty output file for the output package, with the generated-code header.
+func newOutputFile(cfg config.OutputConfig, t types.Named) *jen.File {
+	outFile := jen.NewFilePathName(outPkgPath(cfg, t), cfg.OutPkg)
+	commandSuffix := ""
+	if cfg.Command != "" {
+		commandSuffix = " via `" + cfg.Command + "`"
 	}
+	outFile.HeaderComment(fmt.Sprintf(preambleTemplate, commandSuffix))
+	return outFile
 }
 
-func (c *CodeGenerator) Generate(t types.Named) error {
+// generateUnion adds the union generated from t to outFile.
+func generateUnion(cfg config.OutputConfig, t types.Named, outFile *jen.File) error {
 	// Validate that the provided type is a struct.
 	s, ok := t.Type.(types.Struct)
 	if !ok {
@@ -138,24 +539,33 @@ func (c *CodeGenerator) Generate(t types.Named) error {
 	}
 
 	sf := newStructFields(s.Fields)
+	sf.compact = cfg.Compact
+	sf.valueFunc = fmt.Sprintf(valueFuncNameTemplate, t.Name)
+	sf.boxFunc = fmt.Sprintf(boxFuncNameTemplate, t.Name)
+	pkgPath := outPkgPath(cfg, t)
+	sf.innerTypePkg, sf.innerTypeName = t.Package, t.Name
+	if pkgPath != t.Package {
+		t = localizeTypeParams(t)
+		s = t.Type.(types.Struct)
+		if err := checkExported(t, pkgPath); err != nil {
+			return err
+		}
+		sf.innerTypePkg, sf.innerTypeName = pkgPath, fmt.Sprintf(innerTypeNameTemplate, t.Name)
+	}
 
 	gi, err := newGenericsInfo(t.TypeParams)
 	if err != nil {
 		return err
 	}
-
-	outFile := jen.NewFilePathName(t.Package, c.config.OutPkg)
-	commandSuffix := ""
-	if c.config.Command != "" {
-		commandSuffix = " via `" + c.config.Command + "`"
+	sf.names, err = newNaming(cfg.Naming, cfg.NameTemplates, cfg.OutType)
+	if err != nil {
+		return err
 	}
-	outFile.HeaderComment(fmt.Sprintf(preambleTemplate, commandSuffix))
 
 	variantTypeName := fmt.Sprintf(variantNameTemplate, t.Name)
-	outFile.Type().Id(variantTypeName).Int().Line()
 
 	variants := make([]variant, 0, len(s.Fields))
-	if !c.config.Default { // Insert default invalid variant at beginning
+	if !cfg.Default { // Insert default invalid variant at beginning
 		variants = append(variants, variant{
 			name:      sf.invalidName,
 			constName: variantTypeName + "_" + sf.invalidName,
@@ -167,63 +577,145 @@ func (c *CodeGenerator) Generate(t types.Named) error {
 		if err != nil {
 			return fmt.Errorf("failed to convert type for field %s: %w", field.Var.Name, err)
 		}
+		record, err := recordFields(field.Var.Type)
+		if err != nil {
+			return fmt.Errorf("failed to convert type for field %s: %w", field.Var.Name, err)
+		}
+		opts, err := parseFieldOptions(field.Tag)
+		if err != nil {
+			return fmt.Errorf("invalid gunion tag on field %s: %w", field.Var.Name, err)
+		}
 		f := field // copy for pointer stability
 		variants = append(variants, variant{
 			name:      field.Var.Name,
 			constName: variantTypeName + "_" + field.Var.Name,
 			field:     &f,
 			typeCode:  code,
+			unit:      isUnitType(field.Var.Type),
+			record:    record,
+			opts:      opts,
 		})
 	}
 
+	if err := assignVariantValues(variants, !cfg.Default); err != nil {
+		return err
+	}
+	if err := checkVariantNames(variants); err != nil {
+		return err
+	}
+	if err := checkNames(cfg, variants, sf.names); err != nil {
+		return err
+	}
+	if cfg.Sealed {
+		return generateSealed(cfg, variants, &sf, &gi, outFile)
+	}
+	if cfg.StableVariants {
+		if err := checkStableVariants(cfg.OutFile, variantTypeName, variants); err != nil {
+			return err
+		}
+	}
+
+	outFile.Type().Id(variantTypeName).Int().Line()
+
 	outFile.Const().DefsFunc(func(g *jen.Group) {
-		for i, variant := range variants {
+		for _, variant := range variants {
 			// Could also use iota here, but this is more explicit and easier to generate.
-			g.Id(variant.constName).Qual(t.Package, variantTypeName).Op("=").Lit(i)
+			g.Id(variant.constName).Qual(pkgPath, variantTypeName).Op("=").Lit(variant.value)
 		}
 	})
 
 	generateStringer(variants, variantTypeName, outFile)
 
-	// Build inner field: _inner myUnion or _inner myUnion[T, U].
-	innerType := jen.Qual(t.Package, t.Name)
-	if len(gi.typeArgs) > 0 {
-		innerType = innerType.Types(gi.typeArgs...)
+	var inner jen.Code
+	if sf.compact {
+		generateValueFunc(sf.valueFunc, outFile)
+		generateBoxFunc(sf.boxFunc, outFile)
+		inner = jen.Id(sf.valueField).Any()
+	} else {
+		// The source type's fields can't be accessed from another package, so use a copy of it instead.
+		if sf.innerTypePkg != t.Package {
+			generateInnerCopy(variants, sf.innerTypeName, &gi, outFile)
+		}
+
+		// Build inner field: _inner myUnion or _inner myUnion[T, U].
+		inner = jen.Id(sf.innerField).Add(sf.innerType(&gi))
 	}
-	inner := jen.Id(sf.innerField).Add(innerType)
 
 	// Build type definition: type OutType struct or type OutType[T any, U comparable] struct.
-	typeDef := outFile.Type().Id(c.config.OutType)
+	typeDef := outFile.Type().Id(cfg.OutType)
 	if len(gi.typeParamDefs) > 0 {
 		typeDef = typeDef.Types(gi.typeParamDefs...)
 	}
 	typeDef.Struct(
-		jen.Id(sf.variantField).Qual(t.Package, variantTypeName),
+		jen.Id(sf.variantField).Qual(pkgPath, variantTypeName),
 		inner,
 	)
 
 	for _, variant := range variants {
-		if c.config.Getters {
-			generateIs(variant, c.config.OutType, &sf, &gi, outFile)
-			// Unwrap/Get only make sense for variants that have a type (not Invalid).
-			if variant.field != nil {
-				generateUnwrap(variant, c.config.OutType, &sf, &gi, outFile)
-				generateGet(variant, c.config.OutType, &sf, &gi, outFile)
+		if cfg.Getters && !variant.opts.noGetters {
+			generateIs(variant, cfg.OutType, &sf, &gi, outFile)
+			// Unwrap/Get only make sense for variants that carry a value (not Invalid or unit variants).
+			if variant.hasPayload() {
+				generateUnwrap(variant, cfg.OutType, &sf, &gi, outFile)
+				generateGet(variant, cfg.OutType, &sf, &gi, outFile)
+				generateTry(variant, cfg.OutType, &sf, &gi, outFile)
+				generatePtr(variant, cfg.OutType, &sf, &gi, outFile)
 			}
 		}
-		if c.config.Setters {
-			generateConstructor(variant, c.config.OutType, t, &sf, &gi, outFile)
+		if cfg.Setters {
+			generateConstructor(variant, cfg.OutType, &sf, &gi, outFile)
+			generateSet(variant, cfg.OutType, &sf, &gi, outFile)
 		}
 	}
 
-	if c.config.Match {
-		generateMatch(variants, c.config.OutType, &sf, &gi, outFile)
+	if cfg.Match {
+		generateMatch(variants, cfg.OutType, &sf, &gi, outFile)
 	}
 
-	// Write the generated code to the output file.
-	err = outFile.Save(c.config.OutFile)
-	if err != nil {
-		return fmt.Errorf("failed to save generated code: %w", err)
+	if cfg.MatchE {
+		generateMatchE(variants, cfg.OutType, &sf, &gi, outFile)
+	}
+
+	if cfg.Switch {
+		generateSwitch(variants, cfg.OutType, &sf, &gi, outFile)
+	}
+
+	if cfg.MatchOr {
+		generateMatchOr(variants, cfg.OutType, &sf, &gi, outFile)
+	}
+
+	if cfg.Visitor {
+		generateVisitor(variants, cfg.OutType, &sf, &gi, outFile)
+	}
+
+	if cfg.Kind {
+		if err := generateKind(variants, cfg.OutType, variantTypeName, &sf, &gi, outFile); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Equal {
+		generateEqual(variants, cfg.OutType, newEqualityChecker(t.Package, t.TypeParams), &sf, &gi, outFile)
+	}
+
+	if cfg.Format {
+		generateFormat(variants, cfg.OutType, cfg.OutPkg, &sf, &gi, outFile)
+	}
+
+	if cfg.Slog {
+		generateLogValue(variants, cfg.OutType, &sf, &gi, outFile)
+	}
+
+	if cfg.SQL != config.SQLNone {
+		if err := generateSQL(variants, cfg.OutType, cfg, &sf, &gi, outFile); err != nil {
+			return err
+		}
+	}
+
+	if cfg.JSON != config.JSONNone {
+		if err := generateJSON(variants, cfg.OutType, cfg.JSON, &sf, &gi, outFile); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -243,7 +735,7 @@ func generateStringer(variants []variant, variantTypeName string, outFile *jen.F
 	var cases []jen.Code
 	for _, v := range variants {
 		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
-			jen.Return(jen.Lit(v.name)),
+			jen.Return(jen.Lit(v.displayName())),
 		))
 	}
 	cases = append(cases, jen.Default().Block(
@@ -263,7 +755,8 @@ func generateStringer(variants []variant, variantTypeName string, outFile *jen.F
 //	    return u.variant == <constName>
 //	}
 func generateIs(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
-	methodName := fmt.Sprintf(isVariantNameTemplate, v.name)
+	methodName := sf.names.variant(nameIs, v)
+	addDeprecation(v, outFile)
 	outFile.Func().Params(
 		gi.receiverType(outType),
 	).Id(methodName).Params().Bool().Block(
@@ -271,26 +764,39 @@ func generateIs(v variant, outType string, sf *structFields, gi *genericsInfo, o
 	).Line()
 }
 
+// wrongVariantError builds a *gunion.WrongVariantError for accessing variant v of the union u.
+//
+//	&gunion.WrongVariantError{Union: "OutType", Want: "<Variant>", Got: u._variant.String()}
+func wrongVariantError(v variant, outType string, sf *structFields) *jen.Statement {
+	return jen.Op("&").Qual(runtimePkgPath, "WrongVariantError").Values(
+		jen.Id("Union").Op(":").Lit(outType),
+		jen.Id("Want").Op(":").Lit(v.displayName()),
+		jen.Id("Got").Op(":").Id("u").Dot(sf.variantField).Dot("String").Call(),
+	)
+}
+
 // generateUnwrap generates the Unwrap_<Variant> method on the union type.
-// Panics if the union is not the expected variant (similar to Rust's unwrap semantics).
+// Panics with a *gunion.WrongVariantError if the union is not the expected variant (similar to
+// Rust's unwrap semantics).
 //
 //	func (u *OutType[T, U]) Unwrap_<Variant>() <Type> {
 //	    if u.variant != <constName> {
-//	        panic("unwrap called on wrong variant")
+//	        panic(&gunion.WrongVariantError{...})
 //	    }
 //	    return u.inner.<Variant>
 //	}
 func generateUnwrap(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
-	methodName := fmt.Sprintf(unwrapVariantNameTemplate, v.name)
+	methodName := sf.names.variant(nameUnwrap, v)
 
 	// Access path to the field: u._inner.<Name>.
-	fieldAccess := jen.Id("u").Dot(sf.innerField).Dot(v.name)
+	fieldAccess := sf.fieldAccess("u", v)
 
+	addDeprecation(v, outFile)
 	outFile.Func().Params(
 		gi.receiverType(outType),
 	).Id(methodName).Params().Add(v.typeCode).Block(
 		jen.If(jen.Id("u").Dot(sf.variantField).Op("!=").Id(v.constName)).Block(
-			jen.Panic(jen.Lit(fmt.Sprintf("called %s on wrong variant", methodName))),
+			jen.Panic(wrongVariantError(v, outType, sf)),
 		),
 		jen.Return(fieldAccess),
 	).Line()
@@ -307,11 +813,12 @@ func generateUnwrap(v variant, outType string, sf *structFields, gi *genericsInf
 //	    return zero, false
 //	}
 func generateGet(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
-	methodName := fmt.Sprintf(getVariantNameTemplate, v.name)
+	methodName := sf.names.variant(nameGet, v)
 
 	// Access path to the field: u._inner.<Name>.
-	fieldAccess := jen.Id("u").Dot(sf.innerField).Dot(v.name)
+	fieldAccess := sf.fieldAccess("u", v)
 
+	addDeprecation(v, outFile)
 	outFile.Func().Params(
 		gi.receiverType(outType),
 	).Id(methodName).Params().Params(v.typeCode, jen.Bool()).Block(
@@ -323,19 +830,100 @@ func generateGet(v variant, outType string, sf *structFields, gi *genericsInfo,
 	).Line()
 }
 
-// generateMatch generates a generic Match function for exhaustive pattern matching.
-// Real variant arms come first; the Invalid arm (if present) comes last.
-// All arms must be explicitly handled.
+// generateTry generates the Try_<Variant> method on the union type.
+// Returns the value, or a *gunion.WrongVariantError if the variant didn't match.
 //
-// For non-generic types:
+//	func (u *OutType[T, U]) Try_<Variant>() (<Type>, error) {
+//	    if u.variant != <constName> {
+//	        var zero <Type>
+//	        return zero, &gunion.WrongVariantError{...}
+//	    }
+//	    return u.inner.<Variant>, nil
+//	}
+func generateTry(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	methodName := sf.names.variant(nameTry, v)
+
+	addDeprecation(v, outFile)
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id(methodName).Params().Params(v.typeCode, jen.Error()).Block(
+		jen.If(jen.Id("u").Dot(sf.variantField).Op("!=").Id(v.constName)).Block(
+			jen.Var().Id("zero").Add(v.typeCode),
+			jen.Return(jen.Id("zero"), wrongVariantError(v, outType, sf)),
+		),
+		jen.Return(sf.fieldAccess("u", v), jen.Nil()),
+	).Line()
+}
+
+// generatePtr generates the Ptr_<Variant> method on the union type.
+// Returns a pointer to the payload, through which it can be updated in place, or nil if the
+// variant didn't match.
 //
-//	func Match_OutType[_R any](u *OutType, on_a func(int) _R, on_Invalid func() _R) _R { ... }
+//	func (u *OutType[T, U]) Ptr_<Variant>() *<Type> {
+//	    if u.variant != <constName> {
+//	        return nil
+//	    }
+//	    return &u.inner.<Variant>
+//	}
 //
-// For generic types:
+// The compact layout already stores a pointer to the payload, which is returned instead:
 //
-//	func Match_OutType[T any, U comparable, _R any](u *OutType[T, U], on_a func(T) _R, on_Invalid func() _R) _R { ... }
-func generateMatch(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
-	// Reorder: real variants first, invalid last.
+//	val, _ := u._value.(*<Type>)
+//	return val
+func generatePtr(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	methodName := sf.names.variant(namePtr, v)
+
+	ret := []jen.Code{jen.Return(jen.Op("&").Add(sf.fieldAccess("u", v)))}
+	if sf.compact {
+		ret = []jen.Code{
+			jen.List(jen.Id("val"), jen.Id("_")).Op(":=").Id("u").Dot(sf.valueField).Assert(jen.Op("*").Add(v.typeCode)),
+			jen.Return(jen.Id("val")),
+		}
+	}
+	addDeprecation(v, outFile)
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id(methodName).Params().Op("*").Add(v.typeCode).BlockFunc(func(g *jen.Group) {
+		g.If(jen.Id("u").Dot(sf.variantField).Op("!=").Id(v.constName)).Block(
+			jen.Return(jen.Nil()),
+		)
+		for _, stmt := range ret {
+			g.Add(stmt)
+		}
+	}).Line()
+}
+
+// generateSet generates the Set_<Variant> method on the union type, which switches it to the variant
+// in place. The whole union is overwritten, so the payload of the previous variant doesn't keep
+// anything alive. Record variants take the whole struct, as returned by Unwrap_.
+//
+//	func (u *OutType[T, U]) Set_<Variant>(val <Type>) {
+//	    *u = OutType[T, U]{_variant: <constName>, _inner: myUnion[T, U]{<Variant>: val}}
+//	}
+//
+// For the Invalid variant and unit variants:
+//
+//	func (u *OutType[T, U]) Set_Invalid() { ... }
+func generateSet(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	methodName := sf.names.variant(nameSet, v)
+
+	var params []jen.Code
+	var val jen.Code
+	if v.hasPayload() {
+		params = []jen.Code{jen.Id("val").Add(v.typeCode)}
+		val = jen.Id("val")
+	}
+	addDeprecation(v, outFile)
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id(methodName).Params(params...).Block(
+		jen.Op("*").Id("u").Op("=").Add(unionLiteral(v, outType, sf, gi, val)),
+	).Line()
+}
+
+// matchOrder returns the variants in the order Match takes their arms: real variants in
+// declaration order, then Invalid last.
+func matchOrder(variants []variant) []variant {
 	var realVariants []variant
 	var invalidVariant *variant
 	for i := range variants {
@@ -349,56 +937,24 @@ func generateMatch(variants []variant, outType string, sf *structFields, gi *gen
 	if invalidVariant != nil {
 		ordered = append(ordered, *invalidVariant)
 	}
+	return ordered
+}
 
-	// Result type param name (unique from source type params).
-	resultParam := gi.matchResultParam
-	if resultParam == "" {
-		resultParam = "_R"
-	}
-
-	// Build type param list: source type params + result param.
-	matchTypeParams := make([]jen.Code, 0, len(gi.typeParamDefs)+1)
-	matchTypeParams = append(matchTypeParams, gi.typeParamDefs...)
-	matchTypeParams = append(matchTypeParams, jen.Id(resultParam).Any())
-
-	// Build parameter list: u *OutType[T, U], then one func param per variant.
-	uParam := jen.Id("u").Op("*").Id(outType)
-	if len(gi.typeArgs) > 0 {
-		uParam = uParam.Types(gi.typeArgs...)
-	}
-	params := []jen.Code{uParam}
-	for _, v := range ordered {
-		armName := fmt.Sprintf(matchArmNameTemplate, v.name)
-		if v.field != nil {
-			params = append(params, jen.Id(armName).Func().Params(v.typeCode).Id(resultParam))
-		} else {
-			params = append(params, jen.Id(armName).Func().Params().Id(resultParam))
-		}
-	}
-
-	// Build switch cases.
-	var cases []jen.Code
-	for _, v := range ordered {
-		armName := fmt.Sprintf(matchArmNameTemplate, v.name)
-		var callExpr *jen.Statement
-		if v.field != nil {
-			fieldAccess := jen.Id("u").Dot(sf.innerField).Dot(v.name)
-			callExpr = jen.Id(armName).Call(fieldAccess)
-		} else {
-			callExpr = jen.Id(armName).Call()
-		}
-		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
-			jen.Return(callExpr),
-		))
-	}
-	cases = append(cases, jen.Default().Block(
-		jen.Panic(jen.Lit("unreachable")),
-	))
-
-	matchFuncName := fmt.Sprintf(matchFuncNameTemplate, outType)
-	outFile.Func().Id(matchFuncName).Types(matchTypeParams...).Params(params...).Id(resultParam).Block(
-		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
-	).Line()
+// generateMatch generates a generic Match function for exhaustive pattern matching.
+// Real variant arms come first; the Invalid arm (if present) comes last.
+// All arms must be explicitly handled.
+//
+// For non-generic types:
+//
+//	func Match_OutType[_R any](u *OutType, on_a func(int) _R, on_Invalid func() _R) _R { ... }
+//
+// For generic types:
+//
+//	func Match_OutType[T any, U comparable, _R any](u *OutType[T, U], on_a func(T) _R, on_Invalid func() _R) _R { ... }
+func generateMatch(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	matchFuncName := sf.names.union(nameMatch)
+	results := []jen.Code{jen.Id(gi.resultParam())}
+	generateExhaustiveMatch(matchFuncName, results, variants, outType, sf, gi, outFile)
 }
 
 // generateConstructor generates a constructor function for a variant.
@@ -411,49 +967,53 @@ func generateMatch(variants []variant, outType string, sf *structFields, gi *gen
 //
 //	func NewOutType_a[T any, U comparable](val T) OutType[T, U] { ... }
 //
-// For the Invalid variant (non-generic):
+// For record variants, with one parameter per field of the anonymous struct:
+//
+//	func NewOutType_rect(w float64, h float64) OutType { ... }
+//
+// For the Invalid variant and unit variants (non-generic):
 //
 //	func NewOutType_Invalid() OutType { ... }
 //
-// For the Invalid variant (generic):
+// For the Invalid variant and unit variants (generic):
 //
 //	func NewOutType_Invalid[T any, U comparable]() OutType[T, U] { ... }
-func generateConstructor(v variant, outType string, source types.Named, sf *structFields, gi *genericsInfo, outFile *jen.File) {
-	funcName := fmt.Sprintf(constructorNameTemplate, outType, v.name)
+func generateConstructor(v variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	funcName := sf.names.variant(nameConstructor, v)
 
-	// Source type instantiation: myUnion or myUnion[T, U].
-	sourceType := jen.Qual(source.Package, source.Name)
-	if len(gi.typeArgs) > 0 {
-		sourceType = sourceType.Types(gi.typeArgs...)
+	addDeprecation(v, outFile)
+	funcDef := outFile.Func().Id(funcName)
+	if len(gi.typeParamDefs) > 0 {
+		funcDef = funcDef.Types(gi.typeParamDefs...)
 	}
 
-	if v.field == nil {
-		// Invalid variant: no value parameters.
-		funcDef := outFile.Func().Id(funcName)
-		if len(gi.typeParamDefs) > 0 {
-			funcDef = funcDef.Types(gi.typeParamDefs...)
-		}
+	if !v.hasPayload() {
+		// Invalid or unit variant: no value parameters.
 		funcDef.Params().Add(gi.returnType(outType)).Block(
-			jen.Return(gi.returnType(outType).Values(jen.Dict{
-				jen.Id(sf.variantField): jen.Id(v.constName),
-			})),
+			jen.Return(unionLiteral(v, outType, sf, gi, nil)),
 		).Line()
 		return
 	}
 
-	funcDef := outFile.Func().Id(funcName)
-	if len(gi.typeParamDefs) > 0 {
-		funcDef = funcDef.Types(gi.typeParamDefs...)
+	if v.record != nil {
+		// Record variant: one parameter per field, assembled into the anonymous struct.
+		names := recordParams(v, outType, sf, gi)
+		params := make([]jen.Code, len(v.record))
+		values := make([]jen.Code, len(v.record))
+		for i, f := range v.record {
+			params[i] = jen.Id(names[i]).Add(f.typeCode)
+			values[i] = jen.Id(f.name).Op(":").Id(names[i])
+		}
+		funcDef.Params(params...).Add(gi.returnType(outType)).Block(
+			jen.Return(unionLiteral(v, outType, sf, gi, jen.Add(v.typeCode).Values(values...))),
+		).Line()
+		return
 	}
+
 	funcDef.Params(
 		jen.Id("val").Add(v.typeCode),
 	).Add(gi.returnType(outType)).Block(
-		jen.Return(gi.returnType(outType).Values(jen.Dict{
-			jen.Id(sf.variantField): jen.Id(v.constName),
-			jen.Id(sf.innerField): sourceType.Values(jen.Dict{
-				jen.Id(v.name): jen.Id("val"),
-			}),
-		})),
+		jen.Return(unionLiteral(v, outType, sf, gi, jen.Id("val"))),
 	).Line()
 }
 
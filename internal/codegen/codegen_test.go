@@ -10,10 +10,17 @@ import (
 	testdata_aliasedimport "github.com/sidkurella/gunion/internal/testdata/aliasedimport"
 	testdata_basic "github.com/sidkurella/gunion/internal/testdata/basic"
 	testdata_collision "github.com/sidkurella/gunion/internal/testdata/collision"
+	testdata_crosspkg "github.com/sidkurella/gunion/internal/testdata/crosspkg"
 	testdata_externalimport "github.com/sidkurella/gunion/internal/testdata/externalimport"
 	testdata_generics "github.com/sidkurella/gunion/internal/testdata/generics"
 	testdata_imported "github.com/sidkurella/gunion/internal/testdata/imported"
+	testdata_jsoninternal "github.com/sidkurella/gunion/internal/testdata/jsoninternal"
+	testdata_multi "github.com/sidkurella/gunion/internal/testdata/multi"
+	testdata_pinned "github.com/sidkurella/gunion/internal/testdata/pinned"
+	testdata_record "github.com/sidkurella/gunion/internal/testdata/record"
+	testdata_tagged "github.com/sidkurella/gunion/internal/testdata/tagged"
 	testdata_torture "github.com/sidkurella/gunion/internal/testdata/torture"
+	testdata_unit "github.com/sidkurella/gunion/internal/testdata/unit"
 	"github.com/sidkurella/gunion/internal/types"
 	"github.com/stretchr/testify/require"
 )
@@ -173,6 +180,649 @@ func TestCodeGenerator(t *testing.T) {
 			inNamed:  testdata_torture.Representation,
 			outFile:  "../testdata/torture/gen.go",
 		},
+		{
+			name: "basic, externally tagged JSON",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_json_external_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json external",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONExternal,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/json/external/gen.go",
+		},
+		{
+			name: "basic, adjacently tagged JSON",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_json_adjacent_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json adjacent",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONAdjacent,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/json/adjacent/gen.go",
+		},
+		{
+			name: "jsoninternal, internally tagged JSON",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "jsoninternal",
+				OutFile: tmpDir + "/jsoninternal_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json internal",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONInternal,
+			},
+			outError: nil,
+			inNamed:  testdata_jsoninternal.Representation,
+			outFile:  "../testdata/jsoninternal/gen.go",
+		},
+		{
+			name: "pinned, explicit variant numbers",
+			inConfig: config.OutputConfig{
+				OutType:        "MyUnionUnion",
+				OutPkg:         "pinned",
+				OutFile:        tmpDir + "/pinned_gunion.go",
+				Command:        "gunion --type myUnion --src source.go --no-default --stable-variants",
+				Getters:        true,
+				Setters:        true,
+				Match:          true,
+				Default:        false,
+				StableVariants: true,
+			},
+			outError: nil,
+			inNamed:  testdata_pinned.Representation,
+			outFile:  "../testdata/pinned/gen.go",
+		},
+		{
+			name: "basic, visitor",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_visitor_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --visitor",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Visitor: true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/visitor/gen.go",
+		},
+		{
+			name: "generics, visitor",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_visitor_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --visitor",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Visitor: true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/visitor/gen.go",
+		},
+		{
+			name: "basic, match-or",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_matchor_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --match-or",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				MatchOr: true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/matchor/gen.go",
+		},
+		{
+			name: "generics, match-or",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_matchor_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --match-or",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				MatchOr: true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/matchor/gen.go",
+		},
+		{
+			name: "basic, match-e and switch",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_matche_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --match-e --switch",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				MatchE:  true,
+				Switch:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/matche/gen.go",
+		},
+		{
+			name: "generics, match-e and switch",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_matche_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --match-e --switch",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				MatchE:  true,
+				Switch:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/matche/gen.go",
+		},
+		{
+			name: "basic, kind",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_kind_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --kind",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Kind:    true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/kind/gen.go",
+		},
+		{
+			name: "generics, kind",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_kind_gunion.go",
+				Command: "gunion --type myUnion --src source.go --kind",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Kind:    true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/kind/gen.go",
+		},
+		{
+			name: "crosspkg, another package",
+			inConfig: config.OutputConfig{
+				OutType:    "MyUnionUnion",
+				OutPkg:     "out",
+				OutPkgPath: "github.com/sidkurella/gunion/internal/testdata/crosspkg/out",
+				OutFile:    tmpDir + "/crosspkg_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default " +
+					"--out-pkg-path github.com/sidkurella/gunion/internal/testdata/crosspkg/out",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+			},
+			outError: nil,
+			inNamed:  testdata_crosspkg.Representation,
+			outFile:  "../testdata/crosspkg/out/gen.go",
+		},
+		{
+			name: "crosspkg, source package",
+			inConfig: config.OutputConfig{
+				OutType:    "MyUnionUnion",
+				OutPkg:     "crosspkg",
+				OutPkgPath: "github.com/sidkurella/gunion/internal/testdata/crosspkg",
+				OutFile:    tmpDir + "/crosspkg_same_gunion.go",
+				Command:    "gunion --type myUnion --src source.go --no-default",
+				Getters:    true,
+				Setters:    true,
+				Match:      true,
+				Default:    false,
+			},
+			outError: nil,
+			inNamed:  testdata_crosspkg.Representation,
+			outFile:  "../testdata/crosspkg/gen.go",
+		},
+		{
+			name: "unit",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "unit",
+				OutFile: tmpDir + "/unit_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+			},
+			outError: nil,
+			inNamed:  testdata_unit.Representation,
+			outFile:  "../testdata/unit/gen.go",
+		},
+		{
+			name: "unit/json",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "unit",
+				OutFile: tmpDir + "/unit_json_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json external",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONExternal,
+			},
+			outError: nil,
+			inNamed:  testdata_unit.Representation,
+			outFile:  "../testdata/unit/json/gen.go",
+		},
+		{
+			name: "record",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/gen.go",
+		},
+		{
+			name: "record/visitor",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_visitor_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --visitor --match-or",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Visitor: true,
+				MatchOr: true,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/visitor/gen.go",
+		},
+		{
+			name: "record/json",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_json_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json adjacent",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONAdjacent,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/json/gen.go",
+		},
+		{
+			name: "basic/sealed",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_sealed_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --sealed --match-e --switch",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				MatchE:  true,
+				Switch:  true,
+				Sealed:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/sealed/gen.go",
+		},
+		{
+			name: "generics/sealed",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_sealed_gunion.go",
+				Command: "gunion --type myUnion --src source.go --sealed",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Sealed:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/sealed/gen.go",
+		},
+		{
+			name: "basic/compact",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_compact_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --compact",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Compact: true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/compact/gen.go",
+		},
+		{
+			name: "generics/compact",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_compact_gunion.go",
+				Command: "gunion --type myUnion --src source.go --compact",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Compact: true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/compact/gen.go",
+		},
+		{
+			name: "torture/equal",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "torture",
+				OutFile: tmpDir + "/torture_equal_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --equal",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Equal:   true,
+			},
+			outError: nil,
+			inNamed:  testdata_torture.Representation,
+			outFile:  "../testdata/torture/equal/gen.go",
+		},
+		{
+			name: "generics/equal",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_equal_gunion.go",
+				Command: "gunion --type myUnion --src source.go --equal",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Equal:   true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/equal/gen.go",
+		},
+		{
+			name: "basic/format",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_format_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --format",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Format:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/format/gen.go",
+		},
+		{
+			name: "generics/format",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "generics",
+				OutFile: tmpDir + "/generics_format_gunion.go",
+				Command: "gunion --type myUnion --src source.go --format",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Format:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_generics.Representation,
+			outFile:  "../testdata/generics/format/gen.go",
+		},
+		{
+			name: "record/format",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_format_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --format",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Format:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/format/gen.go",
+		},
+		{
+			name: "basic/slog",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_slog_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --slog",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Slog:    true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/slog/gen.go",
+		},
+		{
+			name: "record/slog",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_slog_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --slog",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Slog:    true,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/slog/gen.go",
+		},
+		{
+			name: "basic/sql-json",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_sql-json_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --json adjacent --sql json",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				JSON:    config.JSONAdjacent,
+				SQL:     config.SQLJSON,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/sql-json/gen.go",
+		},
+		{
+			name: "basic/sql-columns",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_sql-columns_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --sql columns",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				SQL:     config.SQLColumns,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/sql-columns/gen.go",
+		},
+		{
+			name: "record/sql-columns",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "record",
+				OutFile: tmpDir + "/record_sql-columns_gunion.go",
+				Command: "gunion --type myUnion --src source.go --sql columns",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				SQL:     config.SQLColumns,
+			},
+			outError: nil,
+			inNamed:  testdata_record.Representation,
+			outFile:  "../testdata/record/sql-columns/gen.go",
+		},
+		{
+			name: "tagged, per-variant options",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "tagged",
+				OutFile: tmpDir + "/tagged_gunion.go",
+				Command: "gunion --type myUnion --src source.go",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+			},
+			outError: nil,
+			inNamed:  testdata_tagged.Representation,
+			outFile:  "../testdata/tagged/gen.go",
+		},
+		{
+			name: "tagged, with kind, format, slog, json and sql",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "tagged",
+				OutFile: tmpDir + "/tagged_all_gunion.go",
+				Command: "gunion --type myUnion --src source.go --kind --format --slog --json external --sql columns",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: true,
+				Kind:    true,
+				Format:  true,
+				Slog:    true,
+				JSON:    config.JSONExternal,
+				SQL:     config.SQLColumns,
+			},
+			outError: nil,
+			inNamed:  testdata_tagged.Representation,
+			outFile:  "../testdata/tagged/all/gen.go",
+		},
+		{
+			name: "basic, camel naming",
+			inConfig: config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "basic",
+				OutFile: tmpDir + "/basic_camel_gunion.go",
+				Command: "gunion --type myUnion --src source.go --no-default --naming camel --match-e --switch --match-or --visitor --kind --format",
+				Getters: true,
+				Setters: true,
+				Match:   true,
+				Default: false,
+				Naming:  config.NamingCamel,
+				MatchE:  true,
+				Switch:  true,
+				MatchOr: true,
+				Visitor: true,
+				Kind:    true,
+				Format:  true,
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/camel/gen.go",
+		},
+		{
+			name: "basic, custom name templates",
+			inConfig: config.OutputConfig{
+				OutType:       "MyUnionUnion",
+				OutPkg:        "basic",
+				OutFile:       tmpDir + "/basic_template_gunion.go",
+				Command:       "gunion --type myUnion --src source.go --no-default --name-template is=Has{Variant},constructor=Make{Type}{Variant}",
+				Getters:       true,
+				Setters:       true,
+				Match:         true,
+				Default:       false,
+				NameTemplates: map[string]string{"is": "Has{Variant}", "constructor": "Make{Type}{Variant}"},
+			},
+			outError: nil,
+			inNamed:  testdata_basic.Representation,
+			outFile:  "../testdata/basic/template/gen.go",
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -192,6 +842,102 @@ func TestCodeGenerator(t *testing.T) {
 	}
 }
 
+func TestCodeGeneratorMultipleTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	multiConfig := func(outType, outFile, command string) config.OutputConfig {
+		return config.OutputConfig{
+			OutType: outType,
+			OutPkg:  "multi",
+			OutFile: outFile,
+			Command: command,
+			Getters: true,
+			Setters: true,
+			Match:   true,
+			Default: false,
+		}
+	}
+
+	t.Run("combined output file", func(t *testing.T) {
+		command := "gunion --type myUnion,otherUnion --src source.go --no-default"
+		outFile := tmpDir + "/multi_gunion.go"
+		cg := codegen.NewCodeGenerator(
+			multiConfig("MyUnionUnion", outFile, command),
+			multiConfig("OtherUnionUnion", outFile, command),
+		)
+		err := cg.Generate(testdata_multi.Representation, testdata_multi.OtherRepresentation)
+		require.NoError(t, err)
+
+		actual, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		expected, err := os.ReadFile("../testdata/multi/gen.go")
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+	})
+
+	t.Run("one output file per type", func(t *testing.T) {
+		command := "gunion --type myUnion,otherUnion --src source.go --split --no-default"
+		cg := codegen.NewCodeGenerator(
+			multiConfig("MyUnionUnion", tmpDir+"/multi_myUnion_gunion.go", command),
+			multiConfig("OtherUnionUnion", tmpDir+"/multi_otherUnion_gunion.go", command),
+		)
+		err := cg.Generate(testdata_multi.Representation, testdata_multi.OtherRepresentation)
+		require.NoError(t, err)
+
+		for outFile, goldenFile := range map[string]string{
+			tmpDir + "/multi_myUnion_gunion.go":    "../testdata/multi/split/myunion.go",
+			tmpDir + "/multi_otherUnion_gunion.go": "../testdata/multi/split/otherunion.go",
+		} {
+			actual, err := os.ReadFile(outFile)
+			require.NoError(t, err)
+			expected, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(actual))
+		}
+	})
+
+	t.Run("shared output file with different packages", func(t *testing.T) {
+		outFile := tmpDir + "/mismatch_gunion.go"
+		other := multiConfig("OtherUnionUnion", outFile, "")
+		other.OutPkg = "elsewhere"
+		cg := codegen.NewCodeGenerator(multiConfig("MyUnionUnion", outFile, ""), other)
+		err := cg.Generate(testdata_multi.Representation, testdata_multi.OtherRepresentation)
+		require.EqualError(t, err, "unions written to "+outFile+" must share an output package, got multi and elsewhere")
+		_, err = os.Stat(outFile)
+		require.True(t, os.IsNotExist(err), "nothing should be written when generation fails")
+	})
+
+	t.Run("type count must match config count", func(t *testing.T) {
+		cg := codegen.NewCodeGenerator(multiConfig("MyUnionUnion", tmpDir+"/count_gunion.go", ""))
+		err := cg.Generate(testdata_multi.Representation, testdata_multi.OtherRepresentation)
+		require.EqualError(t, err, "got 2 types for 1 output configs")
+	})
+}
+
+func TestCodeGeneratorRender(t *testing.T) {
+	cfg := config.OutputConfig{
+		OutType: "MyUnionUnion",
+		OutPkg:  "basic",
+		OutFile: t.TempDir() + "/basic_gunion.go",
+		Command: "gunion --type myUnion --src source.go --no-default",
+		Getters: true,
+		Setters: true,
+		Match:   true,
+		Default: false,
+	}
+	cg := codegen.NewCodeGenerator(cfg)
+	files, err := cg.Render(testdata_basic.Representation)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile("../testdata/basic/gen.go")
+	require.NoError(t, err)
+	require.Equal(t, []codegen.File{{Path: cfg.OutFile, Content: expected}}, files)
+
+	// Nothing is written to disk.
+	_, err = os.Stat(cfg.OutFile)
+	require.True(t, os.IsNotExist(err))
+}
+
 func TestCodeGeneratorErrors(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -234,6 +980,172 @@ func TestCodeGeneratorErrors(t *testing.T) {
 		require.Contains(t, err.Error(), "expected a struct type")
 	})
 
+	t.Run("unknown JSON style", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "basic",
+			OutFile: tmpDir + "/badjson_gunion.go",
+			JSON:    config.JSONStyle("sideways"),
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_basic.Representation)
+		require.EqualError(t, err, `failed to generate union for type myUnion: unknown JSON style "sideways"`)
+	})
+
+	t.Run("internal JSON style with a payload that isn't an object", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "basic",
+			OutFile: tmpDir + "/internaljson_gunion.go",
+			JSON:    config.JSONInternal,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_basic.Representation)
+		require.EqualError(t, err, "failed to generate union for type myUnion: "+
+			"json style internal requires payloads that encode to JSON objects, but variant a does not")
+	})
+
+	t.Run("record fields exporting to the same JSON field", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "test",
+			OutFile: tmpDir + "/recordjson_gunion.go",
+			JSON:    config.JSONExternal,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type: types.Struct{Fields: []types.Field{
+				{Var: types.Var{Name: "rect", Type: types.Struct{Fields: []types.Field{
+					{Var: types.Var{Name: "w", Type: types.Basic{Name: "float64"}}},
+					{Var: types.Var{Name: "W", Type: types.Basic{Name: "float64"}}},
+				}}}},
+			}},
+		})
+		require.EqualError(t, err,
+			"failed to generate union for type myUnion: fields w and W of variant rect both export as W")
+	})
+
+	t.Run("kind constant collision", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "test",
+			OutFile: tmpDir + "/kindcollision_gunion.go",
+			Kind:    true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type: types.Struct{Fields: []types.Field{
+				{Var: types.Var{Name: "circle", Type: types.Basic{Name: "int"}}},
+				{Var: types.Var{Name: "Circle", Type: types.Basic{Name: "int"}}},
+			}},
+		})
+		require.EqualError(t, err,
+			"failed to generate union for type myUnion: variants circle and Circle both have kind MyUnionUnionKindCircle")
+	})
+
+	t.Run("non-ASCII kind constant collision", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "test",
+			OutFile: tmpDir + "/kindcollision_gunion.go",
+			Kind:    true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type: types.Struct{Fields: []types.Field{
+				{Var: types.Var{Name: "ñu", Type: types.Basic{Name: "int"}}},
+				{Var: types.Var{Name: "Ñu", Type: types.Basic{Name: "int"}}},
+			}},
+		})
+		require.EqualError(t, err,
+			"failed to generate union for type myUnion: variants ñu and Ñu both have kind MyUnionUnionKindÑu")
+	})
+
+	t.Run("unexported type used from another package", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType:    "BadUnionUnion",
+			OutPkg:     "out",
+			OutPkgPath: "github.com/sidkurella/gunion/internal/testdata/crosspkg/out",
+			OutFile:    tmpDir + "/crosspkgbad_gunion.go",
+			Getters:    true,
+			Setters:    true,
+			Match:      true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_crosspkg.BadRepresentation)
+		require.EqualError(t, err,
+			"failed to generate union for type badUnion: field b uses unexported type "+
+				"github.com/sidkurella/gunion/internal/testdata/crosspkg.secret, which can't be referenced from package "+
+				"github.com/sidkurella/gunion/internal/testdata/crosspkg/out")
+	})
+
+	t.Run("sealed with unsupported option", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "basic",
+			OutFile: tmpDir + "/sealedvisitor_gunion.go",
+			Match:   true,
+			Visitor: true,
+			Sealed:  true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_basic.Representation)
+		require.EqualError(t, err, "failed to generate union for type myUnion: visitor cannot be used with sealed")
+	})
+
+	t.Run("sealed with unsupported tag option", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "tagged",
+			OutFile: tmpDir + "/sealedtagged_gunion.go",
+			Match:   true,
+			Sealed:  true,
+			Default: true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_tagged.Representation)
+		require.EqualError(t, err, "failed to generate union for type myUnion: gunion tag option name on field circle cannot be used with sealed")
+	})
+
+	t.Run("sql json without json style", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "basic",
+			OutFile: tmpDir + "/sqljson_gunion.go",
+			Match:   true,
+			SQL:     config.SQLJSON,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(testdata_basic.Representation)
+		require.EqualError(t, err, "failed to generate union for type myUnion: sql encoding json requires a json style")
+	})
+
+	t.Run("sealed type collision", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "test",
+			OutFile: tmpDir + "/sealedcollision_gunion.go",
+			Sealed:  true,
+		}
+		cg := codegen.NewCodeGenerator(cfg)
+		err := cg.Generate(types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type: types.Struct{Fields: []types.Field{
+				{Var: types.Var{Name: "circle", Type: types.Basic{Name: "int"}}},
+				{Var: types.Var{Name: "Circle", Type: types.Basic{Name: "int"}}},
+			}},
+		})
+		require.EqualError(t, err,
+			"failed to generate union for type myUnion: variants circle and Circle both have type MyUnionUnionCircle")
+	})
+
 	t.Run("empty struct (no fields)", func(t *testing.T) {
 		cfg := config.OutputConfig{
 			OutType: "MyUnionUnion",
@@ -297,3 +1209,310 @@ func TestCodeGeneratorCommand(t *testing.T) {
 		require.Equal(t, "// Code generated by gunion. DO NOT EDIT.", firstLine)
 	})
 }
+
+func TestCodeGeneratorNaming(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// withFields builds a union of int fields with the given names.
+	withFields := func(names ...string) types.Named {
+		fields := make([]types.Field, len(names))
+		for i, name := range names {
+			fields[i] = types.Field{Var: types.Var{Name: name, Type: types.Basic{Name: "int"}}}
+		}
+		return types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type:    types.Struct{Fields: fields},
+		}
+	}
+
+	cases := []struct {
+		name      string
+		inNamed   types.Named
+		naming    config.NamingScheme
+		templates map[string]string
+		kind      bool
+		sealed    bool
+		sql       config.SQLEncoding
+		outError  string
+	}{
+		{
+			name:     "fields capitalizing to the same name",
+			inNamed:  withFields("circle", "Circle"),
+			naming:   config.NamingCamel,
+			outError: "variant circle (is) and variant Circle (is) both generate method IsCircle",
+		},
+		{
+			name:     "non-ASCII fields capitalizing to the same name",
+			inNamed:  withFields("élan", "Élan"),
+			naming:   config.NamingCamel,
+			outError: "variant élan (is) and variant Élan (is) both generate method IsÉlan",
+		},
+		{
+			name:      "templates generating the same name",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"get": "Is{Variant}"},
+			naming:    config.NamingCamel,
+			outError:  "variant a (is) and variant a (get) both generate method IsA",
+		},
+		{
+			name:      "template generating a fixed method",
+			inNamed:   withFields("kind"),
+			templates: map[string]string{"is": "{Variant}"},
+			kind:      true,
+			outError:  "variant kind (is) and Kind both generate method Kind",
+		},
+		{
+			name:      "template generating the kind type",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"variants": "{Type}Kind"},
+			kind:      true,
+			outError:  "variants and MyUnionUnionKind both generate identifier MyUnionUnionKind",
+		},
+		{
+			name:      "template generating a kind constant",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"constructor": "{Type}Kind{Variant}"},
+			kind:      true,
+			outError:  "variant Invalid (constructor) and variant Invalid (kind) both generate identifier MyUnionUnionKindInvalid",
+		},
+		{
+			name:      "template generating a sealed variant type",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"match": "{Type}A"},
+			sealed:    true,
+			outError:  "match and variant a (sealed type) both generate identifier MyUnionUnionA",
+		},
+		{
+			name:      "template generating the SQL payload type",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"match": "{Type}Payload"},
+			sql:       config.SQLColumns,
+			outError:  "match and MyUnionUnionPayload both generate identifier MyUnionUnionPayload",
+		},
+		{
+			name:      "template generating an invalid identifier",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"is": "Is-{variant}"},
+			outError:  `variant Invalid (is) generates method "Is-Invalid", which is not a valid identifier`,
+		},
+		{
+			name:      "variant template without the variant",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"is": "IsIt"},
+			outError:  "name template is=IsIt must include {variant} or {Variant}",
+		},
+		{
+			name:      "unknown template",
+			inNamed:   withFields("a"),
+			templates: map[string]string{"equal": "Same"},
+			outError:  `unknown name template "equal"`,
+		},
+		{
+			name:     "unknown scheme",
+			inNamed:  withFields("a"),
+			naming:   "kebab",
+			outError: `unknown naming scheme "kebab"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := codegen.NewCodeGenerator(config.OutputConfig{
+				OutType:       "MyUnionUnion",
+				OutPkg:        "pkg",
+				OutFile:       tmpDir + "/naming_gunion.go",
+				Getters:       true,
+				Setters:       true,
+				Match:         true,
+				Kind:          tc.kind,
+				Sealed:        tc.sealed,
+				SQL:           tc.sql,
+				Naming:        tc.naming,
+				NameTemplates: tc.templates,
+			})
+			err := cg.Generate(tc.inNamed)
+			require.EqualError(t, err, "failed to generate union for type myUnion: "+tc.outError)
+		})
+	}
+}
+
+func TestCodeGeneratorVariantNumbering(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// withTags builds a union of int fields a, b, c, ... with the given struct tags.
+	withTags := func(tags ...string) types.Named {
+		fields := make([]types.Field, len(tags))
+		for i, tag := range tags {
+			fields[i] = types.Field{
+				Var: types.Var{Name: string(rune('a' + i)), Type: types.Basic{Name: "int"}},
+				Tag: tag,
+			}
+		}
+		return types.Named{
+			Name:    "myUnion",
+			Package: "example.com/pkg",
+			Type:    types.Struct{Fields: fields},
+		}
+	}
+
+	errorCases := []struct {
+		name     string
+		inNamed  types.Named
+		dflt     bool
+		outError string
+	}{
+		{
+			name:     "some fields unpinned",
+			inNamed:  withTags(`gunion:"id=1"`, ``, `gunion:"id=3"`),
+			outError: `fields a, c pin their variant number but b do not; pin every variant with a gunion:"id=N" tag`,
+		},
+		{
+			name:     "duplicate id",
+			inNamed:  withTags(`gunion:"id=1"`, `gunion:"id=1"`),
+			outError: "variant b reuses number 1 of variant a",
+		},
+		{
+			name:     "id 0 is reserved for Invalid",
+			inNamed:  withTags(`gunion:"id=0"`, `gunion:"id=1"`),
+			outError: "variant a reuses number 0 of variant Invalid",
+		},
+		{
+			name:     "no zero variant without Invalid",
+			inNamed:  withTags(`gunion:"id=1"`, `gunion:"id=2"`),
+			dflt:     true,
+			outError: "no variant is numbered 0, so the zero value of the union would not be a valid variant; number the default variant 0 or use --no-default",
+		},
+		{
+			name:     "malformed id",
+			inNamed:  withTags(`gunion:"id=one"`),
+			outError: `invalid gunion tag on field a: invalid id "one": must be a non-negative integer`,
+		},
+		{
+			name:     "unknown option",
+			inNamed:  withTags(`gunion:"color=red"`),
+			outError: `invalid gunion tag on field a: unknown option "color"`,
+		},
+		{
+			name:     "option without its value",
+			inNamed:  withTags(`gunion:"name="`),
+			outError: "invalid gunion tag on field a: option name requires a value",
+		},
+		{
+			name:     "flag option with a value",
+			inNamed:  withTags(`gunion:"nogetters=true"`),
+			outError: "invalid gunion tag on field a: option nogetters takes no value",
+		},
+		{
+			name:     "default with Invalid",
+			inNamed:  withTags(``, `gunion:"default"`),
+			outError: "field b is marked default, but the zero value of the union is Invalid",
+		},
+		{
+			name:     "several defaults",
+			inNamed:  withTags(`gunion:"default"`, ``, `gunion:"default"`),
+			dflt:     true,
+			outError: "fields a, c are all marked default; only one variant can be",
+		},
+		{
+			name:     "pinned default not numbered 0",
+			inNamed:  withTags(`gunion:"id=0"`, `gunion:"id=1,default"`),
+			dflt:     true,
+			outError: "default variant b must be numbered 0, not 1",
+		},
+		{
+			name:     "duplicate names",
+			inNamed:  withTags(`gunion:"name=b"`, ``),
+			outError: "variants a and b are both named b",
+		},
+		{
+			name:     "duplicate json tags",
+			inNamed:  withTags(`gunion:"json=x"`, `gunion:"json=x"`),
+			outError: "variants a and b both have json tag x",
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cg := codegen.NewCodeGenerator(config.OutputConfig{
+				OutType: "MyUnionUnion",
+				OutPkg:  "pkg",
+				OutFile: tmpDir + "/numbering_gunion.go",
+				Default: tc.dflt,
+			})
+			err := cg.Generate(tc.inNamed)
+			require.EqualError(t, err, "failed to generate union for type myUnion: "+tc.outError)
+		})
+	}
+
+	t.Run("pinned numbers survive reordering", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType:        "MyUnionUnion",
+			OutPkg:         "pkg",
+			OutFile:        tmpDir + "/reorder_gunion.go",
+			StableVariants: true,
+		}
+		named := withTags(`gunion:"id=1"`, `gunion:"id=2"`)
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(named))
+
+		fields := named.Type.(types.Struct).Fields
+		reordered := named
+		reordered.Type = types.Struct{Fields: []types.Field{fields[1], fields[0]}}
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(reordered))
+	})
+
+	t.Run("stable variants rejects renumbering", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType:        "MyUnionUnion",
+			OutPkg:         "pkg",
+			OutFile:        tmpDir + "/renumber_gunion.go",
+			StableVariants: true,
+		}
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(withTags(`gunion:"id=1"`, `gunion:"id=2"`)))
+
+		err := codegen.NewCodeGenerator(cfg).Generate(withTags(`gunion:"id=1"`, `gunion:"id=3"`))
+		require.EqualError(t, err, "failed to generate union for type myUnion: "+"variant b was numbered 2 but is now numbered 3")
+	})
+
+	t.Run("stable variants rejects positional renumbering", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType:        "MyUnionUnion",
+			OutPkg:         "pkg",
+			OutFile:        tmpDir + "/positional_gunion.go",
+			StableVariants: true,
+		}
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(withTags(``, ``)))
+
+		// Inserting a field before b shifts it from 2 to 3.
+		named := withTags(``, ``, ``)
+		fields := named.Type.(types.Struct).Fields
+		fields[1].Var.Name, fields[2].Var.Name = "z", "b"
+		err := codegen.NewCodeGenerator(cfg).Generate(named)
+		require.EqualError(t, err, "failed to generate union for type myUnion: "+"variant b was numbered 2 but is now numbered 3")
+	})
+
+	t.Run("stable variants rejects reusing a removed variant's number", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType:        "MyUnionUnion",
+			OutPkg:         "pkg",
+			OutFile:        tmpDir + "/reuse_gunion.go",
+			StableVariants: true,
+		}
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(withTags(`gunion:"id=1"`, `gunion:"id=2"`)))
+
+		// Rename b to z, keeping its number.
+		named := withTags(`gunion:"id=1"`, `gunion:"id=2"`)
+		named.Type.(types.Struct).Fields[1].Var.Name = "z"
+		err := codegen.NewCodeGenerator(cfg).Generate(named)
+		require.EqualError(t, err, "failed to generate union for type myUnion: "+"variant z reuses number 2, which previously belonged to removed variant b")
+	})
+
+	t.Run("without stable variants, renumbering is allowed", func(t *testing.T) {
+		cfg := config.OutputConfig{
+			OutType: "MyUnionUnion",
+			OutPkg:  "pkg",
+			OutFile: tmpDir + "/unstable_gunion.go",
+			Default: true,
+		}
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(withTags(`gunion:"id=0"`, `gunion:"id=2"`)))
+		require.NoError(t, codegen.NewCodeGenerator(cfg).Generate(withTags(`gunion:"id=0"`, `gunion:"id=3"`)))
+	})
+}
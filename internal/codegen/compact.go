@@ -0,0 +1,39 @@
+package codegen
+
+import "github.com/dave/jennifer/jen"
+
+// generateValueFunc generates the function reading a payload of the compact layout, which stores a
+// pointer to it. The zero union stores nothing, so the zero value is returned for it instead of
+// panicking.
+//
+//	func _myUnionValue[T any](v any) T {
+//	    if val, ok := v.(*T); ok {
+//	        return *val
+//	    }
+//	    var zero T
+//	    return zero
+//	}
+func generateValueFunc(funcName string, outFile *jen.File) {
+	outFile.Func().Id(funcName).Types(jen.Id("T").Any()).Params(jen.Id("v").Any()).Id("T").Block(
+		jen.If(
+			jen.List(jen.Id("val"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Op("*").Id("T")),
+			jen.Id("ok"),
+		).Block(
+			jen.Return(jen.Op("*").Id("val")),
+		),
+		jen.Var().Id("zero").Id("T"),
+		jen.Return(jen.Id("zero")),
+	).Line()
+}
+
+// generateBoxFunc generates the function storing a payload of the compact layout. Payloads are
+// stored behind a pointer so that Ptr_ methods can update them in place.
+//
+//	func _myUnionBox[T any](v T) *T {
+//	    return &v
+//	}
+func generateBoxFunc(funcName string, outFile *jen.File) {
+	outFile.Func().Id(funcName).Types(jen.Id("T").Any()).Params(jen.Id("v").Id("T")).Op("*").Id("T").Block(
+		jen.Return(jen.Op("&").Id("v")),
+	).Line()
+}
@@ -0,0 +1,220 @@
+package codegen
+
+import (
+	"fmt"
+	"go/token"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/sidkurella/gunion/internal/types"
+)
+
+// checkExported checks that a union generated from t into the package pkgPath only needs identifiers
+// that package can refer to. Named types must be builtin, declared in pkgPath or exported. Struct
+// fields and interface methods must be exported too, since unexported ones would belong to pkgPath.
+func checkExported(t types.Named, pkgPath string) error {
+	s, ok := t.Type.(types.Struct)
+	if !ok {
+		return fmt.Errorf("expected a struct type, got %T", t.Type)
+	}
+	for _, tp := range t.TypeParams {
+		if name := unexportedName(tp.Constraint, pkgPath); name != "" {
+			return fmt.Errorf(
+				"type parameter %s uses unexported %s, which can't be referenced from package %s", tp.Name, name, pkgPath,
+			)
+		}
+	}
+	for _, field := range s.Fields {
+		if name := unexportedName(field.Var.Type, pkgPath); name != "" {
+			return fmt.Errorf(
+				"field %s uses unexported %s, which can't be referenced from package %s", field.Var.Name, name, pkgPath,
+			)
+		}
+	}
+	return nil
+}
+
+// unexportedName returns a description of the first identifier in typ that can't be referenced
+// from the package pkgPath, or "" if there is none.
+func unexportedName(typ types.Type, pkgPath string) string {
+	switch typ := typ.(type) {
+	case types.Named:
+		if typ.Package != "" && typ.Package != pkgPath && !token.IsExported(typ.Name) {
+			return fmt.Sprintf("type %s.%s", typ.Package, typ.Name)
+		}
+		return firstUnexportedName(typ.TypeArgs, pkgPath)
+	case types.Pointer:
+		return unexportedName(typ.Elem, pkgPath)
+	case types.Slice:
+		return unexportedName(typ.Elem, pkgPath)
+	case types.Array:
+		return unexportedName(typ.Elem, pkgPath)
+	case types.Chan:
+		return unexportedName(typ.Elem, pkgPath)
+	case types.Map:
+		return firstUnexportedName([]types.Type{typ.Key, typ.Value}, pkgPath)
+	case types.Struct:
+		for _, field := range typ.Fields {
+			if !token.IsExported(field.Var.Name) {
+				return fmt.Sprintf("struct field %s", field.Var.Name)
+			}
+			if name := unexportedName(field.Var.Type, pkgPath); name != "" {
+				return name
+			}
+		}
+	case types.Interface:
+		if name := firstUnexportedName(typ.Embeds, pkgPath); name != "" {
+			return name
+		}
+		for _, method := range typ.Methods {
+			if !token.IsExported(method.Name) {
+				return fmt.Sprintf("interface method %s", method.Name)
+			}
+			if name := unexportedName(method.Signature, pkgPath); name != "" {
+				return name
+			}
+		}
+	case types.Signature:
+		for _, vars := range [][]types.Var{typ.Params, typ.Returns} {
+			for _, v := range vars {
+				if name := unexportedName(v.Type, pkgPath); name != "" {
+					return name
+				}
+			}
+		}
+	case types.Union:
+		for _, member := range typ.Members {
+			if name := unexportedName(member.Type, pkgPath); name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// firstUnexportedName returns the result of unexportedName for the first of ts that has one.
+func firstUnexportedName(ts []types.Type, pkgPath string) string {
+	for _, t := range ts {
+		if name := unexportedName(t, pkgPath); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// generateInnerCopy generates a copy of the source struct in the output package, holding the variant
+// payloads of a union generated into another package. Variant types only refer to exported identifiers
+// (see checkExported), so they can be used as they are.
+//
+//	type _myUnionInner[T any, U comparable] struct {
+//	    a T
+//	    b U
+//	}
+func generateInnerCopy(variants []variant, innerTypeName string, gi *genericsInfo, outFile *jen.File) {
+	var fields []jen.Code
+	for _, v := range variants {
+		if v.field != nil {
+			fields = append(fields, jen.Id(v.name).Add(v.typeCode))
+		}
+	}
+
+	typeDef := outFile.Type().Id(innerTypeName)
+	if len(gi.typeParamDefs) > 0 {
+		typeDef = typeDef.Types(gi.typeParamDefs...)
+	}
+	typeDef.Struct(fields...).Line()
+}
+
+// localizeTypeParams returns t with every reference to one of its type parameters made package-less.
+// The loader qualifies type parameters with the package declaring them, which only works when the
+// union is generated into that same package.
+func localizeTypeParams(t types.Named) types.Named {
+	if len(t.TypeParams) == 0 {
+		return t
+	}
+	l := typeParamLocalizer{pkg: t.Package, names: make(map[string]bool, len(t.TypeParams))}
+	for _, tp := range t.TypeParams {
+		l.names[tp.Name] = true
+	}
+
+	ret := t
+	ret.TypeParams = make([]types.TypeParam, len(t.TypeParams))
+	for i, tp := range t.TypeParams {
+		ret.TypeParams[i] = types.TypeParam{Name: tp.Name, Constraint: l.localize(tp.Constraint)}
+	}
+	ret.Type = l.localize(t.Type)
+	return ret
+}
+
+// typeParamLocalizer rewrites references to the type parameter names declared in pkg.
+type typeParamLocalizer struct {
+	pkg   string
+	names map[string]bool
+}
+
+func (l typeParamLocalizer) localize(typ types.Type) types.Type {
+	switch typ := typ.(type) {
+	case types.Named:
+		if typ.Package == l.pkg && l.names[typ.Name] {
+			typ.Package = ""
+		}
+		typ.TypeArgs = l.localizeAll(typ.TypeArgs)
+		return typ
+	case types.Pointer:
+		return types.Pointer{Elem: l.localize(typ.Elem)}
+	case types.Slice:
+		return types.Slice{Elem: l.localize(typ.Elem)}
+	case types.Array:
+		return types.Array{Len: typ.Len, Elem: l.localize(typ.Elem)}
+	case types.Chan:
+		return types.Chan{Direction: typ.Direction, Elem: l.localize(typ.Elem)}
+	case types.Map:
+		return types.Map{Key: l.localize(typ.Key), Value: l.localize(typ.Value)}
+	case types.Struct:
+		fields := make([]types.Field, len(typ.Fields))
+		for i, field := range typ.Fields {
+			fields[i] = field
+			fields[i].Var.Type = l.localize(field.Var.Type)
+		}
+		return types.Struct{Fields: fields}
+	case types.Interface:
+		methods := make([]types.Func, len(typ.Methods))
+		for i, method := range typ.Methods {
+			methods[i] = types.Func{Name: method.Name, Signature: l.localize(method.Signature).(types.Signature)}
+		}
+		return types.Interface{Embeds: l.localizeAll(typ.Embeds), Methods: methods}
+	case types.Signature:
+		typ.Params = l.localizeVars(typ.Params)
+		typ.Returns = l.localizeVars(typ.Returns)
+		return typ
+	case types.Union:
+		members := make([]types.UnionMember, len(typ.Members))
+		for i, member := range typ.Members {
+			members[i] = types.UnionMember{Approximate: member.Approximate, Type: l.localize(member.Type)}
+		}
+		return types.Union{Members: members}
+	default:
+		return typ
+	}
+}
+
+func (l typeParamLocalizer) localizeAll(ts []types.Type) []types.Type {
+	if ts == nil {
+		return nil
+	}
+	ret := make([]types.Type, len(ts))
+	for i, t := range ts {
+		ret[i] = l.localize(t)
+	}
+	return ret
+}
+
+func (l typeParamLocalizer) localizeVars(vars []types.Var) []types.Var {
+	if vars == nil {
+		return nil
+	}
+	ret := make([]types.Var, len(vars))
+	for i, v := range vars {
+		ret[i] = types.Var{Name: v.Name, Type: l.localize(v.Type)}
+	}
+	return ret
+}
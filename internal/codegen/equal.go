@@ -0,0 +1,162 @@
+package codegen
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/sidkurella/gunion/internal/types"
+)
+
+// equality is how two payloads of the same type are compared.
+type equality int
+
+const (
+	// Compare with ==.
+	equalOperator equality = iota
+	// Call the type's Equal method.
+	equalMethod
+	// Call reflect.DeepEqual.
+	equalDeep
+)
+
+// equalityChecker decides how payloads of the source type pkg are compared.
+type equalityChecker struct {
+	pkg string
+	// Constraints of the source type's type parameters, by name.
+	constraints map[string]types.Type
+}
+
+func newEqualityChecker(pkg string, typeParams []types.TypeParam) equalityChecker {
+	constraints := make(map[string]types.Type, len(typeParams))
+	for _, tp := range typeParams {
+		constraints[tp.Name] = tp.Constraint
+	}
+	return equalityChecker{pkg: pkg, constraints: constraints}
+}
+
+// of returns how payloads of type typ are compared. An Equal method is preferred over ==, since
+// it is usually more meaningful (time.Time), and reflect.DeepEqual is the fallback for the rest.
+func (c equalityChecker) of(typ types.Type) equality {
+	if named, ok := typ.(types.Named); ok && named.HasEqual && !c.isTypeParam(named) {
+		return equalMethod
+	}
+	if c.comparable(typ) {
+		return equalOperator
+	}
+	return equalDeep
+}
+
+// isTypeParam reports whether named refers to one of the source type's type parameters. Inside the
+// source struct, these shadow any package-level type of the same name.
+func (c equalityChecker) isTypeParam(named types.Named) bool {
+	_, ok := c.constraints[named.Name]
+	return ok && (named.Package == c.pkg || named.Package == "")
+}
+
+// comparable reports whether every value of type typ can be compared with ==. Unlike for the Go
+// compiler, interfaces don't count, including any and error: comparing them panics if their dynamic
+// values are slices, maps or funcs.
+func (c equalityChecker) comparable(typ types.Type) bool {
+	switch typ := typ.(type) {
+	case types.Basic, types.Pointer, types.Chan:
+		return true
+	case types.Named:
+		if c.isTypeParam(typ) {
+			return c.constraintComparable(c.constraints[typ.Name])
+		}
+		return !typ.Incomparable && !typ.Interface
+	case types.Array:
+		return c.comparable(typ.Elem)
+	case types.Struct:
+		for _, field := range typ.Fields {
+			if !c.comparable(field.Var.Type) {
+				return false
+			}
+		}
+		return true
+	default: // Slices, maps, funcs and interfaces.
+		return false
+	}
+}
+
+// constraintComparable reports whether every type satisfying the constraint is comparable.
+func (c equalityChecker) constraintComparable(constraint types.Type) bool {
+	switch constraint := constraint.(type) {
+	case types.Named:
+		return constraint.Package == "" && constraint.Name == "comparable"
+	case types.Union:
+		for _, member := range constraint.Members {
+			if !c.comparable(member.Type) {
+				return false
+			}
+		}
+		return true
+	case types.Interface:
+		for _, embed := range constraint.Embeds {
+			if c.constraintComparable(embed) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// generateEqual generates an Equal method comparing the variants of two unions, then only their active
+// payloads. How payloads are compared is decided per variant by equalityChecker.of.
+//
+//	func (u *OutType) Equal(other *OutType) bool {
+//	    if u._variant != other._variant {
+//	        return false
+//	    }
+//	    switch u._variant {
+//	    case _myUnionVariant_a:
+//	        return u._inner.a == other._inner.a
+//	    case _myUnionVariant_b:
+//	        return reflect.DeepEqual(u._inner.b, other._inner.b)
+//	    case _myUnionVariant_c:
+//	        val := u._inner.c
+//	        return val.Equal(other._inner.c)
+//	    default:
+//	        return true
+//	    }
+//	}
+func generateEqual(
+	variants []variant, outType string, checker equalityChecker, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) {
+	var cases []jen.Code
+	for _, v := range variants {
+		if !v.hasPayload() {
+			continue
+		}
+		mine, theirs := sf.fieldAccess("u", v), sf.fieldAccess("other", v)
+		var body []jen.Code
+		switch checker.of(v.field.Var.Type) {
+		case equalOperator:
+			body = []jen.Code{jen.Return(jen.Add(mine).Op("==").Add(theirs))}
+		case equalMethod:
+			// Copy the payload first, so that methods on pointer receivers can also be called on it.
+			body = []jen.Code{
+				jen.Id("val").Op(":=").Add(mine),
+				jen.Return(jen.Id("val").Dot("Equal").Call(theirs)),
+			}
+		case equalDeep:
+			body = []jen.Code{jen.Return(jen.Qual("reflect", "DeepEqual").Call(mine, theirs))}
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(body...))
+	}
+	// Invalid and unit variants have no payload to compare.
+	cases = append(cases, jen.Default().Block(
+		jen.Return(jen.True()),
+	))
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("Equal").Params(
+		jen.Id("other").Op("*").Add(gi.returnType(outType)),
+	).Bool().Block(
+		jen.If(jen.Id("u").Dot(sf.variantField).Op("!=").Id("other").Dot(sf.variantField)).Block(
+			jen.Return(jen.False()),
+		),
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
@@ -0,0 +1,113 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateFormat generates String, GoString and Format methods that only print the active variant.
+// They have value receivers, so that unions print the same way whether or not they are pointers.
+//
+//	func (u OutType) String() string { return fmt.Sprint(u) }
+//
+//	// Constructor call that would create u, such as pkg.NewOutType_a(1).
+//	func (u OutType) GoString() string { ... }
+//
+//	// %v and %s print OutType(a: 1), %+v prints the payload with %+v, and %#v calls GoString.
+//	func (u OutType) Format(f fmt.State, verb rune) { ... }
+//
+// Variants without a payload print as OutType(Invalid). GoString leaves out the type arguments of
+// generic unions.
+func generateFormat(variants []variant, outType string, outPkg string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("String").Params().String().Block(
+		jen.Return(jen.Qual("fmt", "Sprint").Call(jen.Id("u"))),
+	).Line()
+
+	generateGoString(variants, outType, outPkg, sf, gi, outFile)
+
+	var cases []jen.Code
+	for _, v := range variants {
+		if !v.hasPayload() {
+			continue
+		}
+		// Names given with gunion:"name=..." may contain %, so they are arguments rather than part of the format.
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+			jen.Qual("fmt", "Fprintf").Call(
+				jen.Id("f"), jen.Lit("%s(%s: ").Op("+").Id("format").Op("+").Lit(")"),
+				jen.Lit(outType), jen.Lit(v.displayName()), sf.fieldAccess("u", v),
+			),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Qual("fmt", "Fprintf").Call(jen.Id("f"), jen.Lit(outType+"(%s)"), jen.Id("u").Dot(sf.variantField)),
+	))
+
+	// The payload format is only declared if a variant has a payload to print with it.
+	formatSelection := jen.Null()
+	if len(cases) > 1 {
+		formatSelection = jen.Add(
+			jen.Id("format").Op(":=").Lit("%v"),
+			jen.Line(),
+			jen.If(jen.Id("f").Dot("Flag").Call(jen.LitRune('+'))).Block(
+				jen.Id("format").Op("=").Lit("%+v"),
+			),
+		)
+	}
+
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("Format").Params(jen.Id("f").Qual("fmt", "State"), jen.Id("verb").Rune()).Block(
+		jen.If(jen.Id("verb").Op("==").LitRune('v').Op("&&").Id("f").Dot("Flag").Call(jen.LitRune('#'))).Block(
+			jen.Qual("io", "WriteString").Call(jen.Id("f"), jen.Id("u").Dot("GoString").Call()),
+			jen.Return(),
+		),
+		jen.If(jen.Id("verb").Op("!=").LitRune('v').Op("&&").Id("verb").Op("!=").LitRune('s')).Block(
+			jen.Qual("fmt", "Fprintf").Call(jen.Id("f"), jen.Lit("%%!%c("+outType+"=%s)"), jen.Id("verb"), jen.Id("u").Dot("String").Call()),
+			jen.Return(),
+		),
+		formatSelection,
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
+
+// generateGoString generates the GoString method, which prints the constructor call that would create the union.
+//
+//	func (u OutType) GoString() string {
+//	    switch u._variant {
+//	    case _myUnionVariant_a:
+//	        return fmt.Sprintf("pkg.NewOutType_a(%#v)", u._inner.a)
+//	    case _myUnionVariant_Invalid:
+//	        return "pkg.NewOutType_Invalid()"
+//	    default:
+//	        return fmt.Sprintf("pkg.OutType(%s)", u._variant)
+//	    }
+//	}
+func generateGoString(variants []variant, outType string, outPkg string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	var cases []jen.Code
+	for _, v := range variants {
+		constructor := outPkg + "." + sf.names.variant(nameConstructor, v)
+		var ret jen.Code
+		if v.hasPayload() {
+			args := sf.payloadArgs("u", v)
+			verbs := strings.TrimSuffix(strings.Repeat("%#v, ", len(args)), ", ")
+			ret = jen.Qual("fmt", "Sprintf").Call(append([]jen.Code{jen.Lit(constructor + "(" + verbs + ")")}, args...)...)
+		} else {
+			ret = jen.Lit(constructor + "()")
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(jen.Return(ret)))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(
+			jen.Lit(outPkg+"."+outType+"(%s)"), jen.Id("u").Dot(sf.variantField),
+		)),
+	))
+
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("GoString").Params().String().Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
@@ -0,0 +1,307 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/sidkurella/gunion/internal/types"
+)
+
+const jsonTypeKey = "type"
+const jsonValueKey = "value"
+
+// generateJSON generates MarshalJSON and UnmarshalJSON methods on the union type
+// using the given tagging style.
+func generateJSON(
+	variants []variant, outType string, style config.JSONStyle,
+	sf *structFields, gi *genericsInfo, outFile *jen.File,
+) error {
+	switch style {
+	case config.JSONExternal, config.JSONAdjacent, config.JSONInternal:
+	default:
+		return fmt.Errorf("unknown JSON style %q", style)
+	}
+	for _, v := range variants {
+		if style == config.JSONInternal && v.field != nil && !mayEncodeAsObject(v.field.Var.Type) {
+			return fmt.Errorf("json style internal requires payloads that encode to JSON objects, but variant %s does not", v.name)
+		}
+		seen := make(map[string]string, len(v.record))
+		for _, f := range v.record {
+			fieldName := exportName(f.name)
+			if other, ok := seen[fieldName]; ok {
+				return fmt.Errorf("fields %s and %s of variant %s both export as %s", other, f.name, v.name, fieldName)
+			}
+			seen[fieldName] = f.name
+		}
+	}
+	generateMarshalJSON(variants, outType, style, sf, gi, outFile)
+	generateUnmarshalJSON(variants, outType, style, sf, gi, outFile)
+	return nil
+}
+
+// mayEncodeAsObject reports whether a payload of type typ may encode to a JSON object. Basic types,
+// slices, arrays and maps whose keys encoding/json can't write as object keys never do. Named types
+// and type parameters may implement json.Marshaler, so they are only checked when encoding.
+func mayEncodeAsObject(typ types.Type) bool {
+	switch t := typ.(type) {
+	case types.Basic, types.Slice, types.Array:
+		return false
+	case types.Map:
+		key, ok := t.Key.(types.Basic)
+		return !ok || key.Name == "string" || isIntegerType(key.Name)
+	case types.Pointer:
+		return mayEncodeAsObject(t.Elem)
+	default:
+		return true
+	}
+}
+
+// isIntegerType reports whether name is a predeclared integer type.
+func isIntegerType(name string) bool {
+	switch name {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune":
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonPayloadType returns the type a variant's payload is encoded as. Record fields are unexported,
+// so records are encoded as a struct with the fields exported, and tagged with their original names.
+func jsonPayloadType(v variant) *jen.Statement {
+	if v.record == nil {
+		return v.typeCode
+	}
+	fields := make([]jen.Code, len(v.record))
+	for i, f := range v.record {
+		fields[i] = jen.Id(exportName(f.name)).Add(f.typeCode).Tag(map[string]string{"json": f.name})
+	}
+	return jen.Struct(fields...)
+}
+
+// jsonPayload returns the value to encode for the payload of variant v of the union recv.
+func jsonPayload(recv string, v variant, sf *structFields) jen.Code {
+	if v.record == nil {
+		return sf.fieldAccess(recv, v)
+	}
+	return jsonPayloadType(v).Values(sf.payloadArgs(recv, v)...)
+}
+
+// jsonDecodedPayload converts val, decoded as jsonPayloadType, back to the payload of variant v.
+func jsonDecodedPayload(v variant, val *jen.Statement) jen.Code {
+	if v.record == nil {
+		return val
+	}
+	fields := make([]jen.Code, len(v.record))
+	for i, f := range v.record {
+		fields[i] = jen.Add(val).Dot(exportName(f.name))
+	}
+	return jen.Add(v.typeCode).Values(fields...)
+}
+
+// generateMarshalJSON generates the MarshalJSON method. It has a value receiver so that
+// unions are encoded the same way whether or not they are addressable.
+// The Invalid variant cannot be encoded.
+//
+//	func (u OutType) MarshalJSON() ([]byte, error) {
+//	    var tag string
+//	    var value any
+//	    switch u._variant {
+//	    case <constName>:
+//	        tag, value = "<Variant>", u._inner.<Variant>
+//	    default:
+//	        return nil, fmt.Errorf("cannot marshal OutType: variant %s has no JSON representation", u._variant)
+//	    }
+//	    <style-specific encoding of tag and value>
+//	}
+func generateMarshalJSON(
+	variants []variant, outType string, style config.JSONStyle, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) {
+	var cases []jen.Code
+	for _, v := range variants {
+		if v.field == nil {
+			continue
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+			jen.List(jen.Id("tag"), jen.Id("value")).Op("=").List(jen.Lit(v.wireTag()), jsonPayload("u", v, sf)),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+			jen.Lit(fmt.Sprintf("cannot marshal %s: variant %%s has no JSON representation", outType)),
+			jen.Id("u").Dot(sf.variantField),
+		)),
+	))
+
+	body := []jen.Code{
+		jen.Var().Id("tag").String(),
+		jen.Var().Id("value").Any(),
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	}
+
+	switch style {
+	case config.JSONExternal:
+		// {"<Variant>": <payload>}
+		body = append(body, jen.Return(jen.Qual("encoding/json", "Marshal").Call(
+			jen.Map(jen.String()).Any().Values(jen.Dict{jen.Id("tag"): jen.Id("value")}),
+		)))
+
+	case config.JSONAdjacent:
+		// {"type": "<Variant>", "value": <payload>}
+		body = append(body, jen.Return(jen.Qual("encoding/json", "Marshal").Call(
+			jen.Struct(
+				jen.Id("Type").String().Tag(map[string]string{"json": jsonTypeKey}),
+				jen.Id("Value").Any().Tag(map[string]string{"json": jsonValueKey}),
+			).Values(jen.Id("tag"), jen.Id("value")),
+		)))
+
+	case config.JSONInternal:
+		// Encode the payload, then add the "type" key to the resulting object.
+		body = append(body,
+			jen.List(jen.Id("payload"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("value")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Var().Id("fields").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("payload"), jen.Op("&").Id("fields")),
+				jen.Err().Op("!=").Nil().Op("||").Id("fields").Op("==").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot marshal %s: variant %%s payload is not a JSON object", outType)),
+					jen.Id("tag"),
+				)),
+			),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("fields").Index(jen.Lit(jsonTypeKey)),
+				jen.Id("ok"),
+			).Block(
+				jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot marshal %s: variant %%s payload already has a %q key", outType, jsonTypeKey)),
+					jen.Id("tag"),
+				)),
+			),
+			jen.List(jen.Id("fields").Index(jen.Lit(jsonTypeKey)), jen.Id("_")).Op("=").
+				Qual("encoding/json", "Marshal").Call(jen.Id("tag")),
+			jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("fields"))),
+		)
+	}
+
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(body...).Line()
+}
+
+// generateUnmarshalJSON generates the UnmarshalJSON method. Unknown variant tags and the
+// Invalid variant produce decode errors.
+//
+//	func (u *OutType) UnmarshalJSON(data []byte) error {
+//	    <style-specific decoding into tag and payload>
+//	    switch tag {
+//	    case "<Variant>":
+//	        var val <Type>
+//	        if err := json.Unmarshal(payload, &val); err != nil {
+//	            return fmt.Errorf("cannot unmarshal OutType variant <Variant>: %w", err)
+//	        }
+//	        *u = OutType{_variant: <constName>, _inner: myUnion{<Variant>: val}}
+//	        return nil
+//	    default:
+//	        return fmt.Errorf("cannot unmarshal OutType: unknown variant %q", tag)
+//	    }
+//	}
+func generateUnmarshalJSON(
+	variants []variant, outType string, style config.JSONStyle,
+	sf *structFields, gi *genericsInfo, outFile *jen.File,
+) {
+	decodeErr := func() jen.Code {
+		return jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("envelope")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("cannot unmarshal %s: %%w", outType)), jen.Err())),
+		)
+	}
+
+	var body []jen.Code
+	switch style {
+	case config.JSONExternal:
+		body = append(body,
+			jen.Var().Id("envelope").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+			decodeErr(),
+			jen.If(jen.Len(jen.Id("envelope")).Op("!=").Lit(1)).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot unmarshal %s: expected exactly one variant key, got %%d", outType)),
+					jen.Len(jen.Id("envelope")),
+				)),
+			),
+			jen.Var().Id("tag").String(),
+			jen.Var().Id("payload").Qual("encoding/json", "RawMessage"),
+			jen.For(jen.List(jen.Id("tag"), jen.Id("payload")).Op("=").Range().Id("envelope")).Block(),
+		)
+
+	case config.JSONAdjacent:
+		body = append(body,
+			jen.Var().Id("envelope").Struct(
+				jen.Id("Type").String().Tag(map[string]string{"json": jsonTypeKey}),
+				jen.Id("Value").Qual("encoding/json", "RawMessage").Tag(map[string]string{"json": jsonValueKey}),
+			),
+			decodeErr(),
+			jen.List(jen.Id("tag"), jen.Id("payload")).Op(":=").List(jen.Id("envelope").Dot("Type"), jen.Id("envelope").Dot("Value")),
+		)
+
+	case config.JSONInternal:
+		// The payload is decoded from the whole object; the "type" key is ignored as an unknown field.
+		body = append(body,
+			jen.Var().Id("envelope").Struct(
+				jen.Id("Type").String().Tag(map[string]string{"json": jsonTypeKey}),
+			),
+			decodeErr(),
+			jen.List(jen.Id("tag"), jen.Id("payload")).Op(":=").List(jen.Id("envelope").Dot("Type"), jen.Id("data")),
+		)
+	}
+
+	var cases []jen.Code
+	for _, v := range variants {
+		if v.field == nil {
+			cases = append(cases, jen.Case(jen.Lit(v.wireTag())).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot unmarshal %s: variant %%q has no JSON representation", outType)),
+					jen.Id("tag"),
+				)),
+			))
+			continue
+		}
+		decode := jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("payload"), jen.Op("&").Id("val")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("cannot unmarshal %s variant %s: %%w", outType, v.wireTag())),
+				jen.Err(),
+			)),
+		)
+		if v.unit && style == config.JSONAdjacent {
+			// Unit variants have no payload to encode, so the value key may be left out.
+			decode = jen.If(jen.Len(jen.Id("payload")).Op(">").Lit(0)).Block(decode)
+		}
+		cases = append(cases, jen.Case(jen.Lit(v.wireTag())).Block(
+			jen.Var().Id("val").Add(jsonPayloadType(v)),
+			decode,
+			jen.Op("*").Id("u").Op("=").Add(unionLiteral(v, outType, sf, gi, jsonDecodedPayload(v, jen.Id("val")))),
+			jen.Return(jen.Nil()),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Return(jen.Qual("fmt", "Errorf").Call(
+			jen.Lit(fmt.Sprintf("cannot unmarshal %s: unknown variant %%q", outType)),
+			jen.Id("tag"),
+		)),
+	))
+	body = append(body, jen.Switch(jen.Id("tag")).Block(cases...))
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(body...).Line()
+}
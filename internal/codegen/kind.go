@@ -0,0 +1,86 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+)
+
+const kindTypeNameTemplate = `%sKind`
+const kindConstNameTemplate = `%sKind%s`
+
+// kindConstNames returns the exported kind constant name for each variant, e.g. OutTypeKindCircle
+// for the variant circle. It is an error for two variants to map to the same name.
+func kindConstNames(variants []variant, outType string) ([]string, error) {
+	names := make([]string, len(variants))
+	seen := make(map[string]string, len(variants))
+	for i, v := range variants {
+		name := fmt.Sprintf(kindConstNameTemplate, outType, exportName(v.name))
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("variants %s and %s both have kind %s", other, v.name, name)
+		}
+		seen[name] = v.name
+		names[i] = name
+	}
+	return names, nil
+}
+
+// generateKind generates an exported kind type mirroring the unexported variant type, so that
+// other packages can switch on, log or store the active variant.
+//
+//	type OutTypeKind int
+//
+//	const (
+//	    OutTypeKindInvalid OutTypeKind = 0
+//	    OutTypeKindA       OutTypeKind = 1
+//	)
+//
+//	func (k OutTypeKind) String() string { return _myUnionVariant(k).String() }
+//
+//	func (u *OutType) Kind() OutTypeKind { return OutTypeKind(u._variant) }
+//
+//	// Kinds of the real variants in declaration order, without Invalid.
+//	func Variants_OutType() []OutTypeKind { ... }
+func generateKind(
+	variants []variant, outType string, variantTypeName string, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) error {
+	constNames, err := kindConstNames(variants, outType)
+	if err != nil {
+		return err
+	}
+	kindTypeName := fmt.Sprintf(kindTypeNameTemplate, outType)
+
+	outFile.Type().Id(kindTypeName).Int().Line()
+
+	var realKinds []jen.Code
+	outFile.Const().DefsFunc(func(g *jen.Group) {
+		for i, v := range variants {
+			if comment, ok := deprecationComment(v); ok {
+				g.Comment(comment)
+			}
+			g.Id(constNames[i]).Id(kindTypeName).Op("=").Lit(v.value)
+			if v.field != nil {
+				realKinds = append(realKinds, jen.Id(constNames[i]))
+			}
+		}
+	})
+
+	outFile.Func().Params(
+		jen.Id("k").Id(kindTypeName),
+	).Id("String").Params().String().Block(
+		jen.Return(jen.Id(variantTypeName).Call(jen.Id("k")).Dot("String").Call()),
+	).Line()
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("Kind").Params().Id(kindTypeName).Block(
+		jen.Return(jen.Id(kindTypeName).Call(jen.Id("u").Dot(sf.variantField))),
+	).Line()
+
+	variantsFuncName := sf.names.union(nameVariants)
+	outFile.Func().Id(variantsFuncName).Params().Index().Id(kindTypeName).Block(
+		jen.Return(jen.Index().Id(kindTypeName).Values(realKinds...)),
+	).Line()
+
+	return nil
+}
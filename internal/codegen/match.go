@@ -0,0 +1,130 @@
+package codegen
+
+import "github.com/dave/jennifer/jen"
+
+// generateMatchE generates an exhaustive match whose arms can fail.
+//
+//	func MatchE_OutType[_R any](u *OutType, on_a func(int) (_R, error), on_Invalid func() (_R, error)) (_R, error) { ... }
+func generateMatchE(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	matchEFuncName := sf.names.union(nameMatchE)
+	results := []jen.Code{jen.Id(gi.resultParam()), jen.Error()}
+	generateExhaustiveMatch(matchEFuncName, results, variants, outType, sf, gi, outFile)
+}
+
+// generateSwitch generates an exhaustive match whose arms return nothing, for side effects only.
+// It only has type parameters if the union does.
+//
+//	func Switch_OutType(u *OutType, on_a func(int), on_Invalid func()) { ... }
+func generateSwitch(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	switchFuncName := sf.names.union(nameSwitch)
+	generateExhaustiveMatch(switchFuncName, nil, variants, outType, sf, gi, outFile)
+}
+
+// generateExhaustiveMatch generates a function taking the union and one arm per variant, which
+// calls the arm for the active variant. Arms are ordered by matchOrder. Each arm returns results,
+// as does the function; if there are no results, the function has no result type parameter either.
+func generateExhaustiveMatch(
+	funcName string, results []jen.Code,
+	variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) {
+	typeParams := gi.typeParamDefs
+	if len(results) > 0 {
+		typeParams = gi.resultTypeParams()
+	}
+
+	// Build parameter list: u *OutType[T, U], then one func param per variant, and the switch cases.
+	params := []jen.Code{gi.receiverType(outType)}
+	var cases []jen.Code
+	for _, v := range matchOrder(variants) {
+		armName := sf.names.variant(nameArm, v)
+		var argTypes, args []jen.Code
+		if v.hasPayload() {
+			argTypes = v.argTypes()
+			args = sf.payloadArgs("u", v)
+		}
+		params = append(params, withResults(jen.Id(armName).Func().Params(argTypes...), results))
+
+		callExpr := jen.Id(armName).Call(args...)
+		if len(results) > 0 {
+			callExpr = jen.Return(callExpr)
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(callExpr))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Panic(jen.Lit("unreachable")),
+	))
+
+	fn := outFile.Func().Id(funcName)
+	if len(typeParams) > 0 {
+		fn = fn.Types(typeParams...)
+	}
+	withResults(fn.Params(params...), results).Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
+
+// withResults adds the result list of a function signature: nothing, a single result, or a parenthesized list.
+func withResults(signature *jen.Statement, results []jen.Code) *jen.Statement {
+	switch len(results) {
+	case 0:
+		return signature
+	case 1:
+		return signature.Add(results[0])
+	default:
+		return signature.Params(results...)
+	}
+}
+
+// generateMatchOr generates a non-exhaustive alternative to Match. Arms are given as fields of a
+// struct, any of which may be left nil, and a fallback is called for every variant without an arm.
+// Arm fields are ordered the same way as Match arms.
+//
+// For non-generic types:
+//
+//	type OutTypeArms[_R any] struct {
+//	    On_a       func(int) _R
+//	    On_Invalid func() _R
+//	}
+//
+//	func MatchOr_OutType[_R any](u *OutType, arms OutTypeArms[_R], fallback func() _R) _R { ... }
+//
+// For generic types, the arms struct also takes the union's type parameters:
+//
+//	func MatchOr_OutType[T any, U comparable, _R any](u *OutType[T, U], arms OutTypeArms[T, U, _R], fallback func() _R) _R { ... }
+func generateMatchOr(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	ordered := matchOrder(variants)
+	resultParam := gi.resultParam()
+	armsName := sf.names.union(nameArms)
+
+	var fields []jen.Code
+	var cases []jen.Code
+	for _, v := range ordered {
+		fieldName := sf.names.variant(nameArmField, v)
+		arm := jen.Id("arms").Dot(fieldName)
+		var callExpr *jen.Statement
+		if v.hasPayload() {
+			fields = append(fields, jen.Id(fieldName).Func().Params(v.argTypes()...).Id(resultParam))
+			callExpr = jen.Add(arm).Call(sf.payloadArgs("u", v)...)
+		} else {
+			fields = append(fields, jen.Id(fieldName).Func().Params().Id(resultParam))
+			callExpr = jen.Add(arm).Call()
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+			jen.If(jen.Add(arm).Op("!=").Nil()).Block(
+				jen.Return(callExpr),
+			),
+		))
+	}
+
+	outFile.Type().Id(armsName).Types(gi.resultTypeParams()...).Struct(fields...).Line()
+
+	matchOrFuncName := sf.names.union(nameMatchOr)
+	outFile.Func().Id(matchOrFuncName).Types(gi.resultTypeParams()...).Params(
+		gi.receiverType(outType),
+		jen.Id("arms").Id(armsName).Types(gi.resultTypeArgs()...),
+		jen.Id("fallback").Func().Params().Id(resultParam),
+	).Id(resultParam).Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+		jen.Return(jen.Id("fallback").Call()),
+	).Line()
+}
@@ -0,0 +1,281 @@
+package codegen
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sidkurella/gunion/internal/config"
+)
+
+// nameKind is a kind of generated identifier whose name follows the naming scheme. Kinds are also the
+// keys of custom name templates.
+type nameKind string
+
+const (
+	nameIs          nameKind = "is"
+	nameUnwrap      nameKind = "unwrap"
+	nameGet         nameKind = "get"
+	nameTry         nameKind = "try"
+	namePtr         nameKind = "ptr"
+	nameSet         nameKind = "set"
+	nameConstructor nameKind = "constructor"
+	nameArm         nameKind = "arm"
+	nameArmField    nameKind = "arm-field"
+	nameVisit       nameKind = "visit"
+	nameMatch       nameKind = "match"
+	nameMatchE      nameKind = "match-e"
+	nameSwitch      nameKind = "switch"
+	nameMatchOr     nameKind = "match-or"
+	nameArms        nameKind = "arms"
+	nameVisitor     nameKind = "visitor"
+	nameAccept      nameKind = "accept"
+	nameVariants    nameKind = "variants"
+)
+
+// Placeholders in name templates.
+const (
+	typePlaceholder            = "{Type}"
+	variantPlaceholder         = "{variant}"
+	exportedVariantPlaceholder = "{Variant}"
+)
+
+// variantNameKinds are the kinds of identifier generated once per variant, whose templates must
+// include the variant.
+var variantNameKinds = []nameKind{
+	nameIs, nameUnwrap, nameGet, nameTry, namePtr, nameSet, nameConstructor, nameArm, nameArmField, nameVisit,
+}
+
+// namingSchemes are the templates of each built-in naming scheme.
+var namingSchemes = map[config.NamingScheme]map[nameKind]string{
+	config.NamingUnderscore: {
+		nameIs:          "Is_{variant}",
+		nameUnwrap:      "Unwrap_{variant}",
+		nameGet:         "Get_{variant}",
+		nameTry:         "Try_{variant}",
+		namePtr:         "Ptr_{variant}",
+		nameSet:         "Set_{variant}",
+		nameConstructor: "New{Type}_{variant}",
+		nameArm:         "on_{variant}",
+		nameArmField:    "On_{variant}",
+		nameVisit:       "Visit_{variant}",
+		nameMatch:       "Match_{Type}",
+		nameMatchE:      "MatchE_{Type}",
+		nameSwitch:      "Switch_{Type}",
+		nameMatchOr:     "MatchOr_{Type}",
+		nameArms:        "{Type}Arms",
+		nameVisitor:     "{Type}Visitor",
+		nameAccept:      "Accept_{Type}",
+		nameVariants:    "Variants_{Type}",
+	},
+	config.NamingCamel: {
+		nameIs:          "Is{Variant}",
+		nameUnwrap:      "Unwrap{Variant}",
+		nameGet:         "Get{Variant}",
+		nameTry:         "Try{Variant}",
+		namePtr:         "Ptr{Variant}",
+		nameSet:         "Set{Variant}",
+		nameConstructor: "New{Type}{Variant}",
+		nameArm:         "on{Variant}",
+		nameArmField:    "On{Variant}",
+		nameVisit:       "Visit{Variant}",
+		nameMatch:       "Match{Type}",
+		nameMatchE:      "MatchE{Type}",
+		nameSwitch:      "Switch{Type}",
+		nameMatchOr:     "MatchOr{Type}",
+		nameArms:        "{Type}Arms",
+		nameVisitor:     "{Type}Visitor",
+		nameAccept:      "Accept{Type}",
+		nameVariants:    "Variants{Type}",
+	},
+}
+
+// naming names the generated identifiers of one union, from the templates of its naming scheme.
+type naming struct {
+	outType   string
+	templates map[nameKind]string
+}
+
+// newNaming returns the naming of the union outType, using the templates of scheme overridden by custom
+// templates. An empty scheme is NamingUnderscore.
+func newNaming(scheme config.NamingScheme, custom map[string]string, outType string) (naming, error) {
+	if scheme == "" {
+		scheme = config.NamingUnderscore
+	}
+	base, ok := namingSchemes[scheme]
+	if !ok {
+		return naming{}, fmt.Errorf("unknown naming scheme %q", scheme)
+	}
+
+	templates := make(map[nameKind]string, len(base))
+	for kind, template := range base {
+		templates[kind] = template
+	}
+	for key, template := range custom {
+		kind := nameKind(key)
+		if _, ok := base[kind]; !ok {
+			return naming{}, fmt.Errorf("unknown name template %q", key)
+		}
+		if slices.Contains(variantNameKinds, kind) &&
+			!strings.Contains(template, variantPlaceholder) && !strings.Contains(template, exportedVariantPlaceholder) {
+			return naming{}, fmt.Errorf(
+				"name template %s=%s must include %s or %s", key, template, variantPlaceholder, exportedVariantPlaceholder,
+			)
+		}
+		templates[kind] = template
+	}
+	return naming{outType: outType, templates: templates}, nil
+}
+
+// union returns the name of an identifier generated once per union, such as its Match function.
+func (n naming) union(kind nameKind) string {
+	return strings.ReplaceAll(n.templates[kind], typePlaceholder, n.outType)
+}
+
+// variant returns the name of an identifier generated for the variant, such as its Is method.
+func (n naming) variant(kind nameKind, v variant) string {
+	return strings.NewReplacer(
+		typePlaceholder, n.outType,
+		variantPlaceholder, v.name,
+		exportedVariantPlaceholder, exportName(v.name),
+	).Replace(n.templates[kind])
+}
+
+// exportName capitalizes the first letter of name. Letters are runes, not bytes, so variants such as
+// élan export as Élan.
+func exportName(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// nameScope collects the identifiers generated into one scope, such as the methods of the union, to
+// report names that aren't identifiers or are generated twice.
+type nameScope struct {
+	// What the names are, such as "method".
+	what string
+	// Who generates each name, such as "variant circle (is)".
+	owners map[string]string
+}
+
+func newNameScope(what string) *nameScope {
+	return &nameScope{what: what, owners: make(map[string]string)}
+}
+
+// add adds a name generated by owner, failing if it isn't an identifier or was already added.
+func (s *nameScope) add(name string, owner string) error {
+	if !token.IsIdentifier(name) {
+		return fmt.Errorf("%s generates %s %q, which is not a valid identifier", owner, s.what, name)
+	}
+	if other, ok := s.owners[name]; ok {
+		return fmt.Errorf("%s and %s both generate %s %s", other, owner, s.what, name)
+	}
+	s.owners[name] = owner
+	return nil
+}
+
+// checkNames reports generated identifiers that collide or aren't valid, e.g. when the camel naming
+// scheme capitalizes fields circle and Circle to the same IsCircle.
+func checkNames(cfg config.OutputConfig, variants []variant, n naming) error {
+	// Fixed names are owned by themselves; names following the naming scheme by their variant and kind.
+	methods := newNameScope("method")
+	pkgNames := newNameScope("identifier")
+	arms := newNameScope("match arm")
+	armFields := newNameScope("arms field")
+	visitMethods := newNameScope("visitor method")
+
+	type entry struct {
+		scope *nameScope
+		name  string
+		owner string
+	}
+	var entries []entry
+	fixed := func(scope *nameScope, enabled bool, names ...string) {
+		if !enabled {
+			return
+		}
+		for _, name := range names {
+			entries = append(entries, entry{scope, name, name})
+		}
+	}
+	unionName := func(scope *nameScope, enabled bool, kind nameKind) {
+		if enabled {
+			entries = append(entries, entry{scope, n.union(kind), string(kind)})
+		}
+	}
+	variantName := func(scope *nameScope, enabled bool, kind nameKind, v variant) {
+		if enabled {
+			entries = append(entries, entry{scope, n.variant(kind, v), fmt.Sprintf("variant %s (%s)", v.name, kind)})
+		}
+	}
+
+	fixed(pkgNames, true, cfg.OutType)
+	// The receiver of generated methods, and the first parameter of Match functions, is u.
+	fixed(arms, true, "u")
+	anyMatch := cfg.Match || cfg.MatchE || cfg.Switch
+	for _, v := range variants {
+		// Sealed unions have no methods or constructors, only match functions.
+		getters := cfg.Getters && !cfg.Sealed && !v.opts.noGetters
+		setters := cfg.Setters && !cfg.Sealed
+		variantName(methods, getters, nameIs, v)
+		variantName(methods, getters && v.hasPayload(), nameUnwrap, v)
+		variantName(methods, getters && v.hasPayload(), nameGet, v)
+		variantName(methods, getters && v.hasPayload(), nameTry, v)
+		variantName(methods, getters && v.hasPayload(), namePtr, v)
+		variantName(methods, setters, nameSet, v)
+		variantName(pkgNames, setters, nameConstructor, v)
+		variantName(arms, anyMatch, nameArm, v)
+		variantName(armFields, cfg.MatchOr, nameArmField, v)
+		variantName(visitMethods, cfg.Visitor, nameVisit, v)
+	}
+	unionName(pkgNames, cfg.Match, nameMatch)
+	unionName(pkgNames, cfg.MatchE, nameMatchE)
+	unionName(pkgNames, cfg.Switch, nameSwitch)
+	unionName(pkgNames, cfg.MatchOr, nameMatchOr)
+	unionName(pkgNames, cfg.MatchOr, nameArms)
+	unionName(pkgNames, cfg.Visitor, nameVisitor)
+	unionName(pkgNames, cfg.Visitor, nameAccept)
+	unionName(pkgNames, cfg.Kind, nameVariants)
+
+	// Kind constants and sealed variant types are exported names of their own, which already report
+	// collisions between variants.
+	fixed(pkgNames, cfg.Kind, fmt.Sprintf(kindTypeNameTemplate, cfg.OutType))
+	if cfg.Kind {
+		constNames, err := kindConstNames(variants, cfg.OutType)
+		if err != nil {
+			return err
+		}
+		for i, v := range variants {
+			entries = append(entries, entry{pkgNames, constNames[i], fmt.Sprintf("variant %s (kind)", v.name)})
+		}
+	}
+	if cfg.Sealed {
+		sealed, err := sealedVariants(matchOrder(variants), cfg.OutType)
+		if err != nil {
+			return err
+		}
+		for _, v := range sealed {
+			if v.typeName != "" {
+				entries = append(entries, entry{pkgNames, v.typeName, fmt.Sprintf("variant %s (sealed type)", v.name)})
+			}
+		}
+	}
+	fixed(pkgNames, cfg.SQL == config.SQLColumns, fmt.Sprintf(sqlPayloadTypeNameTemplate, cfg.OutType))
+
+	fixed(methods, cfg.Kind, "Kind")
+	fixed(methods, cfg.Equal, "Equal")
+	fixed(methods, cfg.Format, "String", "GoString", "Format")
+	fixed(methods, cfg.Slog, "LogValue")
+	fixed(methods, cfg.JSON != config.JSONNone, "MarshalJSON", "UnmarshalJSON")
+	fixed(methods, cfg.SQL != config.SQLNone, "Scan", "Value")
+	fixed(methods, cfg.SQL == config.SQLColumns, "Payload")
+
+	for _, e := range entries {
+		if err := e.scope.add(e.name, e.owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// assignVariantValues sets the enum value of every variant. By default variants are numbered
+// by their position. Fields can instead pin their number with a `gunion:"id=N"` tag; if any
+// field does, every field must, since positional numbers would shift when fields are
+// reordered. The Invalid variant, if present, is always 0. Otherwise, a field tagged
+// `gunion:"default"` is numbered 0 instead of the first field, and the fields before it
+// are numbered from 1.
+//
+// Returns an error if two variants share a number, or if no variant is numbered 0 when
+// there is no Invalid variant (the zero value of the union would not be a valid variant).
+func assignVariantValues(variants []variant, hasInvalid bool) error {
+	var pinned, unpinned, defaults []string
+	for _, v := range variants {
+		if v.field == nil {
+			continue
+		}
+		if v.opts.id != nil {
+			pinned = append(pinned, v.name)
+		} else {
+			unpinned = append(unpinned, v.name)
+		}
+		if v.opts.isDefault {
+			defaults = append(defaults, v.name)
+		}
+	}
+	if len(pinned) > 0 && len(unpinned) > 0 {
+		return fmt.Errorf(
+			"fields %s pin their variant number but %s do not; pin every variant with a gunion:\"id=N\" tag",
+			strings.Join(pinned, ", "), strings.Join(unpinned, ", "),
+		)
+	}
+	if len(defaults) > 0 && hasInvalid {
+		return fmt.Errorf("field %s is marked default, but the zero value of the union is Invalid", defaults[0])
+	}
+	if len(defaults) > 1 {
+		return fmt.Errorf("fields %s are all marked default; only one variant can be", strings.Join(defaults, ", "))
+	}
+
+	// Variants before the default one are shifted up to make room for it at 0.
+	defaultIndex := -1
+	for i, v := range variants {
+		if v.opts.isDefault {
+			defaultIndex = i
+		}
+	}
+
+	owners := make(map[int]string, len(variants))
+	for i := range variants {
+		v := &variants[i]
+		switch {
+		case v.opts.id != nil:
+			v.value = *v.opts.id
+			if v.opts.isDefault && v.value != 0 {
+				return fmt.Errorf("default variant %s must be numbered 0, not %d", v.name, v.value)
+			}
+		case i == defaultIndex:
+			v.value = 0
+		case i < defaultIndex:
+			v.value = i + 1
+		default:
+			v.value = i
+		}
+
+		if owner, ok := owners[v.value]; ok {
+			return fmt.Errorf("variant %s reuses number %d of variant %s", v.name, v.value, owner)
+		}
+		owners[v.value] = v.name
+	}
+
+	if _, ok := owners[0]; !ok && !hasInvalid {
+		return fmt.Errorf(
+			"no variant is numbered 0, so the zero value of the union would not be a valid variant; " +
+				"number the default variant 0 or use --no-default",
+		)
+	}
+	return nil
+}
+
+// checkStableVariants compares the variant numbers against those in a previously generated
+// output file, if one exists. It fails if a variant's number changed, or if a number that
+// belonged to a variant that no longer exists is given to a different variant.
+// This protects variant numbers that have been persisted outside the program.
+func checkStableVariants(outFile string, variantTypeName string, variants []variant) error {
+	previous, err := previousVariantValues(outFile, variantTypeName)
+	if err != nil {
+		return fmt.Errorf("failed to read variant numbers from %s: %w", outFile, err)
+	}
+	if len(previous) == 0 {
+		return nil
+	}
+
+	current := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		current[v.name] = true
+	}
+	previousOwners := make(map[int]string, len(previous))
+	for name, value := range previous {
+		previousOwners[value] = name
+	}
+
+	for _, v := range variants {
+		if value, ok := previous[v.name]; ok && value != v.value {
+			return fmt.Errorf("variant %s was numbered %d but is now numbered %d", v.name, value, v.value)
+		}
+		if owner, ok := previousOwners[v.value]; ok && owner != v.name && !current[owner] {
+			return fmt.Errorf(
+				"variant %s reuses number %d, which previously belonged to removed variant %s",
+				v.name, v.value, owner,
+			)
+		}
+	}
+	return nil
+}
+
+// previousVariantValues reads the variant constants of the given type from a previously
+// generated file, keyed by variant name. Returns an empty map if the file does not exist.
+func previousVariantValues(outFile string, variantTypeName string) (map[string]int, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), outFile, nil, parser.SkipObjectResolution)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]int)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			typ, ok := vs.Type.(*ast.Ident)
+			if !ok || typ.Name != variantTypeName {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			value, err := strconv.Atoi(lit.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for constant %s: %w", vs.Names[0].Name, err)
+			}
+			values[strings.TrimPrefix(vs.Names[0].Name, variantTypeName+"_")] = value
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,243 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/sidkurella/gunion/internal/config"
+)
+
+const sealedTypeNameTemplate = `%s%s`
+const sealedMarkerNameTemplate = `is%s`
+const sealedValueField = "Value"
+
+// sealedVariant is a variant of a sealed interface, with the names of its generated type and fields.
+type sealedVariant struct {
+	variant
+	// Name of the variant's type. Empty for the Invalid variant, which is the nil interface.
+	typeName string
+	// Exported names of the record variant's fields, in the same order as variant.record.
+	recordNames []string
+}
+
+// sealedVariants names the type of each variant, e.g. OutTypeCircle for the variant circle, and the exported
+// fields of record variants. It is an error for two variants, or two fields of a record, to map to the same name.
+func sealedVariants(variants []variant, outType string) ([]sealedVariant, error) {
+	ret := make([]sealedVariant, len(variants))
+	seen := make(map[string]string, len(variants))
+	for i, v := range variants {
+		ret[i].variant = v
+		if v.field == nil {
+			continue
+		}
+		name := fmt.Sprintf(sealedTypeNameTemplate, outType, exportName(v.name))
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("variants %s and %s both have type %s", other, v.name, name)
+		}
+		seen[name] = v.name
+		ret[i].typeName = name
+
+		seenFields := make(map[string]string, len(v.record))
+		for _, f := range v.record {
+			fieldName := exportName(f.name)
+			if other, ok := seenFields[fieldName]; ok {
+				return nil, fmt.Errorf("fields %s and %s of variant %s both export as %s", other, f.name, v.name, fieldName)
+			}
+			seenFields[fieldName] = f.name
+			ret[i].recordNames = append(ret[i].recordNames, fieldName)
+		}
+	}
+	return ret, nil
+}
+
+// generateSealed generates the variants as a sealed interface instead of a union struct: one type
+// per variant, implementing an interface through an unexported marker method. The Invalid variant
+// is the nil interface; without it, Match panics on nil. Of the optional functions, only Match,
+// MatchE and Switch are supported.
+//
+//	type OutType interface {
+//	    isOutType()
+//	}
+//
+//	type OutTypeA struct {
+//	    Value int
+//	}
+//
+//	func (OutTypeA) isOutType() {}
+//
+//	func Match_OutType[_R any](u OutType, on_a func(int) _R, on_Invalid func() _R) _R { ... }
+//
+// Unit variants have no fields, and record variants have their fields exported instead of a Value.
+// For generic types, the marker method takes the type parameters, so that OutTypeA[int] isn't
+// also an OutType[string]:
+//
+//	type OutType[T any, U comparable] interface {
+//	    isOutType(T, U)
+//	}
+func generateSealed(
+	cfg config.OutputConfig, variants []variant, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) error {
+	for _, unsupported := range []struct {
+		enabled bool
+		name    string
+	}{
+		{cfg.MatchOr, "match-or"},
+		{cfg.Visitor, "visitor"},
+		{cfg.Kind, "kind"},
+		{cfg.JSON != config.JSONNone, "json"},
+		{cfg.StableVariants, "stable-variants"},
+		{cfg.Compact, "compact"},
+		{cfg.Equal, "equal"},
+		{cfg.Format, "format"},
+		{cfg.Slog, "slog"},
+		{cfg.SQL != config.SQLNone, "sql"},
+	} {
+		if unsupported.enabled {
+			return fmt.Errorf("%s cannot be used with sealed", unsupported.name)
+		}
+	}
+
+	// Sealed variants are types rather than tags, so the only tag option besides id is deprecated.
+	for _, v := range variants {
+		for _, unsupported := range []struct {
+			enabled bool
+			name    string
+		}{
+			{v.opts.name != "", "name"},
+			{v.opts.json != "", "json"},
+			{v.opts.noGetters, "nogetters"},
+			{v.opts.isDefault, "default"},
+		} {
+			if unsupported.enabled {
+				return fmt.Errorf("gunion tag option %s on field %s cannot be used with sealed", unsupported.name, v.name)
+			}
+		}
+	}
+
+	sealed, err := sealedVariants(matchOrder(variants), cfg.OutType)
+	if err != nil {
+		return err
+	}
+	markerName := fmt.Sprintf(sealedMarkerNameTemplate, cfg.OutType)
+
+	typeDef := outFile.Type().Id(cfg.OutType)
+	if len(gi.typeParamDefs) > 0 {
+		typeDef = typeDef.Types(gi.typeParamDefs...)
+	}
+	typeDef.Interface(
+		jen.Id(markerName).Params(gi.typeArgs...),
+	).Line()
+
+	for _, v := range sealed {
+		if v.typeName == "" {
+			continue
+		}
+		var fields []jen.Code
+		switch {
+		case v.record != nil:
+			for i, f := range v.record {
+				fields = append(fields, jen.Id(v.recordNames[i]).Add(f.typeCode))
+			}
+		case v.hasPayload():
+			fields = append(fields, jen.Id(sealedValueField).Add(v.typeCode))
+		}
+
+		addDeprecation(v.variant, outFile)
+		typeDef := outFile.Type().Id(v.typeName)
+		if len(gi.typeParamDefs) > 0 {
+			typeDef = typeDef.Types(gi.typeParamDefs...)
+		}
+		typeDef.Struct(fields...).Line()
+
+		// The marker's parameters are unnamed, since they are never used.
+		outFile.Func().Params(
+			jen.Id(v.typeName).Types(gi.typeArgs...),
+		).Id(markerName).Params(gi.typeArgs...).Block().Line()
+	}
+
+	if cfg.Match {
+		funcName := sf.names.union(nameMatch)
+		generateSealedMatch(funcName, []jen.Code{jen.Id(gi.resultParam())}, sealed, cfg.OutType, sf, gi, outFile)
+	}
+	if cfg.MatchE {
+		funcName := sf.names.union(nameMatchE)
+		results := []jen.Code{jen.Id(gi.resultParam()), jen.Error()}
+		generateSealedMatch(funcName, results, sealed, cfg.OutType, sf, gi, outFile)
+	}
+	if cfg.Switch {
+		funcName := sf.names.union(nameSwitch)
+		generateSealedMatch(funcName, nil, sealed, cfg.OutType, sf, gi, outFile)
+	}
+	return nil
+}
+
+// generateSealedMatch is generateExhaustiveMatch for a sealed interface: it switches on the type of
+// the interface value instead of the variant tag, and the Invalid arm is called for nil. Variants
+// must already be in match order.
+//
+//	func Match_OutType[_R any](u OutType, on_a func(int) _R, on_Invalid func() _R) _R {
+//	    switch v := u.(type) {
+//	    case OutTypeA:
+//	        return on_a(v.Value)
+//	    case nil:
+//	        return on_Invalid()
+//	    default:
+//	        panic("unreachable")
+//	    }
+//	}
+func generateSealedMatch(
+	funcName string, results []jen.Code,
+	variants []sealedVariant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) {
+	typeParams := gi.typeParamDefs
+	if len(results) > 0 {
+		typeParams = gi.resultTypeParams()
+	}
+
+	params := []jen.Code{jen.Id("u").Add(gi.returnType(outType))}
+	var cases []jen.Code
+	bindsValue := false
+	for _, v := range variants {
+		armName := sf.names.variant(nameArm, v.variant)
+		var argTypes, args []jen.Code
+		if v.hasPayload() {
+			argTypes = v.argTypes()
+			if v.record != nil {
+				for _, name := range v.recordNames {
+					args = append(args, jen.Id("v").Dot(name))
+				}
+			} else {
+				args = []jen.Code{jen.Id("v").Dot(sealedValueField)}
+			}
+			bindsValue = true
+		}
+		params = append(params, withResults(jen.Id(armName).Func().Params(argTypes...), results))
+
+		callExpr := jen.Id(armName).Call(args...)
+		if len(results) > 0 {
+			callExpr = jen.Return(callExpr)
+		}
+		caseType := jen.Nil()
+		if v.typeName != "" {
+			caseType = jen.Id(v.typeName).Types(gi.typeArgs...)
+		}
+		cases = append(cases, jen.Case(caseType).Block(callExpr))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Panic(jen.Lit("unreachable")),
+	))
+
+	// Only bind the value if some arm uses it, since an unused binding doesn't compile.
+	subject := jen.Id("u").Assert(jen.Type())
+	if bindsValue {
+		subject = jen.Id("v").Op(":=").Add(subject)
+	}
+
+	fn := outFile.Func().Id(funcName)
+	if len(typeParams) > 0 {
+		fn = fn.Types(typeParams...)
+	}
+	withResults(fn.Params(params...), results).Block(
+		jen.Switch(subject).Block(cases...),
+	).Line()
+}
@@ -0,0 +1,73 @@
+package codegen
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+const slogVariantKey = "variant"
+const slogValueKey = "value"
+
+// generateLogValue generates a LogValue method, so that slog logs the active variant and its payload
+// rather than the whole union struct. Payloads implementing slog.LogValuer are resolved, and record
+// payloads are logged as a group of their fields. It has a value receiver, like the fmt methods.
+//
+//	func (u OutType) LogValue() slog.Value {
+//	    switch u._variant {
+//	    case _myUnionVariant_a:
+//	        return slog.GroupValue(slog.String("variant", "a"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.a).Resolve()})
+//	    default:
+//	        return slog.GroupValue(slog.String("variant", u._variant.String()))
+//	    }
+//	}
+func generateLogValue(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	var cases []jen.Code
+	for _, v := range variants {
+		if !v.hasPayload() {
+			continue
+		}
+		var value jen.Code
+		if v.record != nil {
+			args := sf.payloadArgs("u", v)
+			fields := make([]jen.Code, len(v.record))
+			for i, f := range v.record {
+				fields[i] = slogAttr(f.name, resolvedValue(args[i]))
+			}
+			value = jen.Qual("log/slog", "GroupValue").Custom(jen.Options{
+				Open: "(", Close: ")", Separator: ",", Multi: true,
+			}, fields...)
+		} else {
+			value = resolvedValue(sf.fieldAccess("u", v))
+		}
+		cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+			jen.Return(jen.Qual("log/slog", "GroupValue").Call(
+				jen.Qual("log/slog", "String").Call(jen.Lit(slogVariantKey), jen.Lit(v.displayName())),
+				slogAttr(slogValueKey, value),
+			)),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Return(jen.Qual("log/slog", "GroupValue").Call(
+			jen.Qual("log/slog", "String").Call(
+				jen.Lit(slogVariantKey), jen.Id("u").Dot(sf.variantField).Dot("String").Call(),
+			),
+		)),
+	))
+
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("LogValue").Params().Qual("log/slog", "Value").Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
+
+// slogAttr returns an slog.Attr literal with the given key and value.
+func slogAttr(key string, value jen.Code) jen.Code {
+	return jen.Qual("log/slog", "Attr").Values(
+		jen.Id("Key").Op(":").Lit(key), jen.Id("Value").Op(":").Add(value),
+	)
+}
+
+// resolvedValue returns the slog.Value of val, calling LogValue if val implements slog.LogValuer.
+func resolvedValue(val jen.Code) jen.Code {
+	return jen.Qual("log/slog", "AnyValue").Call(val).Dot("Resolve").Call()
+}
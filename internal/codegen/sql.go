@@ -0,0 +1,277 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/sidkurella/gunion/internal/config"
+)
+
+const sqlPayloadTypeNameTemplate = `%sPayload`
+
+// generateSQL generates Scan and Value methods on the union type, so that it can be stored with
+// database/sql using the given encoding. NULL is stored for the Invalid variant, and scanned into it.
+func generateSQL(
+	variants []variant, outType string, cfg config.OutputConfig, sf *structFields, gi *genericsInfo, outFile *jen.File,
+) error {
+	switch cfg.SQL {
+	case config.SQLJSON:
+		if cfg.JSON == config.JSONNone {
+			return fmt.Errorf("sql encoding %s requires a json style", cfg.SQL)
+		}
+		generateSQLJSON(variants, outType, sf, gi, outFile)
+	case config.SQLColumns:
+		generateSQLColumns(variants, outType, sf, gi, outFile)
+	default:
+		return fmt.Errorf("unknown sql encoding %q", cfg.SQL)
+	}
+	return nil
+}
+
+// generateSQLJSON generates Scan and Value methods storing the union in a single JSON column,
+// encoded with its MarshalJSON and UnmarshalJSON methods.
+//
+//	func (u OutType) Value() (driver.Value, error) {
+//	    if u._variant == _myUnionVariant_Invalid {
+//	        return nil, nil
+//	    }
+//	    data, err := u.MarshalJSON()
+//	    ...
+//	    return string(data), nil
+//	}
+//
+//	func (u *OutType) Scan(src any) error {
+//	    switch src := src.(type) {
+//	    case nil:
+//	        *u = OutType{}
+//	        return nil
+//	    case string:
+//	        return u.UnmarshalJSON([]byte(src))
+//	    case []byte:
+//	        return u.UnmarshalJSON(src)
+//	    ...
+//	}
+func generateSQLJSON(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	var body []jen.Code
+	if invalid, ok := invalidVariant(variants); ok {
+		body = append(body, jen.If(jen.Id("u").Dot(sf.variantField).Op("==").Id(invalid.constName)).Block(
+			jen.Return(jen.Nil(), jen.Nil()),
+		))
+	}
+	body = append(body,
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("u").Dot("MarshalJSON").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.String().Call(jen.Id("data")), jen.Nil()),
+	)
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(body...).Line()
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("Scan").Params(jen.Id("src").Any()).Error().Block(
+		jen.Switch(jen.Id("src").Op(":=").Id("src").Assert(jen.Type())).Block(
+			sqlNullCase(variants, outType, gi),
+			jen.Case(jen.String()).Block(
+				jen.Return(jen.Id("u").Dot("UnmarshalJSON").Call(jen.Index().Byte().Call(jen.Id("src")))),
+			),
+			jen.Case(jen.Index().Byte()).Block(
+				jen.Return(jen.Id("u").Dot("UnmarshalJSON").Call(jen.Id("src"))),
+			),
+			jen.Default().Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot scan %%T into %s", outType)), jen.Id("src"),
+				)),
+			),
+		),
+	).Line()
+}
+
+// generateSQLColumns generates Scan and Value methods storing the variant name in a discriminator
+// column, and a payload type storing the active payload as JSON in a second column. Scanning the
+// discriminator resets the payload, so it must come before the payload column.
+//
+//	func (u OutType) Value() (driver.Value, error)  // "a", or nil for Invalid
+//	func (u *OutType) Scan(src any) error
+//
+//	type OutTypePayload struct { u *OutType }
+//
+//	func (u *OutType) Payload() OutTypePayload
+//	func (p OutTypePayload) Value() (driver.Value, error) // JSON payload, or nil without one
+//	func (p OutTypePayload) Scan(src any) error
+//
+// Rows are written with db.Exec(query, u, u.Payload()) and read with rows.Scan(&u, u.Payload()).
+func generateSQLColumns(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	var valueCases, scanCases []jen.Code
+	for _, v := range variants {
+		if v.field == nil {
+			valueCases = append(valueCases, jen.Case(jen.Id(v.constName)).Block(jen.Return(jen.Nil(), jen.Nil())))
+			continue
+		}
+		valueCases = append(valueCases, jen.Case(jen.Id(v.constName)).Block(jen.Return(jen.Lit(v.wireTag()), jen.Nil())))
+		scanCases = append(scanCases, jen.Case(jen.Lit(v.wireTag())).Block(
+			jen.Op("*").Id("u").Op("=").Add(gi.returnType(outType)).Values(jen.Dict{
+				jen.Id(sf.variantField): jen.Id(v.constName),
+			}),
+		))
+	}
+	valueCases = append(valueCases, jen.Default().Block(
+		jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+			jen.Lit(fmt.Sprintf("cannot store %s: unknown variant %%s", outType)), jen.Id("u").Dot(sf.variantField),
+		)),
+	))
+	scanCases = append(scanCases, jen.Default().Block(
+		jen.Return(jen.Qual("fmt", "Errorf").Call(
+			jen.Lit(fmt.Sprintf("cannot scan %s: unknown variant %%q", outType)), jen.Id("tag"),
+		)),
+	))
+
+	outFile.Func().Params(
+		gi.valueReceiverType(outType),
+	).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(valueCases...),
+	).Line()
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("Scan").Params(jen.Id("src").Any()).Error().Block(
+		jen.Var().Id("tag").String(),
+		jen.Switch(jen.Id("src").Op(":=").Id("src").Assert(jen.Type())).Block(
+			sqlNullCase(variants, outType, gi),
+			jen.Case(jen.String()).Block(jen.Id("tag").Op("=").Id("src")),
+			jen.Case(jen.Index().Byte()).Block(jen.Id("tag").Op("=").String().Call(jen.Id("src"))),
+			jen.Default().Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot scan %%T into %s", outType)), jen.Id("src"),
+				)),
+			),
+		),
+		jen.Switch(jen.Id("tag")).Block(scanCases...),
+		jen.Return(jen.Nil()),
+	).Line()
+
+	generateSQLPayload(variants, outType, sf, gi, outFile)
+}
+
+// generateSQLPayload generates the payload column type of the columns encoding. It holds a pointer
+// to the union, so that scanning it can read the variant scanned just before.
+func generateSQLPayload(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	payloadName := fmt.Sprintf(sqlPayloadTypeNameTemplate, outType)
+	payloadType := jen.Id(payloadName)
+	if len(gi.typeArgs) > 0 {
+		payloadType = payloadType.Types(gi.typeArgs...)
+	}
+	// The payload methods reach the union through p.u.
+	const recv = "p.u"
+
+	var valueCases, scanCases []jen.Code
+	for _, v := range variants {
+		if !v.hasPayload() {
+			continue
+		}
+		valueCases = append(valueCases, jen.Case(jen.Id(v.constName)).Block(
+			jen.Id("value").Op("=").Add(jsonPayload(recv, v, sf)),
+		))
+		scanCases = append(scanCases, jen.Case(jen.Id(v.constName)).Block(
+			jen.Var().Id("val").Add(jsonPayloadType(v)),
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("val")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit(fmt.Sprintf("cannot scan %s variant %s: %%w", outType, v.name)), jen.Err(),
+				)),
+			),
+			jen.Op("*").Id(recv).Op("=").Add(unionLiteral(v, outType, sf, gi, jsonDecodedPayload(v, jen.Id("val")))),
+		))
+	}
+	valueCases = append(valueCases, jen.Default().Block(jen.Return(jen.Nil(), jen.Nil())))
+
+	outFile.Comment(fmt.Sprintf(
+		"%s stores the active payload of a %s as JSON, in the column after its variant.", payloadName, outType,
+	))
+	outFile.Type().Id(payloadName).Types(gi.typeParamDefs...).Struct(
+		jen.Id("u").Op("*").Add(gi.returnType(outType)),
+	).Line()
+
+	outFile.Func().Params(
+		gi.receiverType(outType),
+	).Id("Payload").Params().Add(payloadType).Block(
+		jen.Return(jen.Add(payloadType).Values(jen.Dict{jen.Id("u"): jen.Id("u")})),
+	).Line()
+
+	valueBody := []jen.Code{
+		jen.Var().Id("value").Any(),
+		jen.Switch(jen.Id(recv).Dot(sf.variantField)).Block(valueCases...),
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("value")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("cannot store %s payload: %%w", outType)), jen.Err(),
+			)),
+		),
+		jen.Return(jen.String().Call(jen.Id("data")), jen.Nil()),
+	}
+	if len(scanCases) == 0 {
+		// Without payloads, the column is always NULL.
+		valueBody = []jen.Code{jen.Return(jen.Nil(), jen.Nil())}
+	}
+	outFile.Func().Params(
+		jen.Id("p").Add(payloadType),
+	).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(valueBody...).Line()
+
+	srcCases := []jen.Code{
+		jen.Case(jen.Nil()),
+		jen.Case(jen.String()).Block(jen.Id("data").Op("=").Index().Byte().Call(jen.Id("src"))),
+		jen.Case(jen.Index().Byte()).Block(jen.Id("data").Op("=").Id("src")),
+		jen.Default().Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("cannot scan %%T into %s payload", outType)), jen.Id("src"),
+			)),
+		),
+	}
+	scanBody := []jen.Code{
+		jen.Var().Id("data").Index().Byte(),
+		jen.Switch(jen.Id("src").Op(":=").Id("src").Assert(jen.Type())).Block(srcCases...),
+		jen.Switch(jen.Id(recv).Dot(sf.variantField)).Block(scanCases...),
+		jen.Return(jen.Nil()),
+	}
+	if len(scanCases) == 0 {
+		// The column is only checked to hold a type it could have been stored as.
+		scanBody = []jen.Code{
+			jen.Switch(jen.Id("src").Assert(jen.Type())).Block(
+				jen.Case(jen.Nil(), jen.String(), jen.Index().Byte()),
+				srcCases[3],
+			),
+			jen.Return(jen.Nil()),
+		}
+	}
+	outFile.Func().Params(
+		jen.Id("p").Add(payloadType),
+	).Id("Scan").Params(jen.Id("src").Any()).Error().Block(scanBody...).Line()
+}
+
+// invalidVariant returns the Invalid variant, if the union has one.
+func invalidVariant(variants []variant) (variant, bool) {
+	for _, v := range variants {
+		if v.field == nil {
+			return v, true
+		}
+	}
+	return variant{}, false
+}
+
+// sqlNullCase returns the case of Scan handling NULL, which resets the union to Invalid. Unions
+// without an Invalid variant can't be NULL.
+func sqlNullCase(variants []variant, outType string, gi *genericsInfo) jen.Code {
+	if _, ok := invalidVariant(variants); !ok {
+		return jen.Case(jen.Nil()).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("cannot scan NULL into %s", outType)))),
+		)
+	}
+	return jen.Case(jen.Nil()).Block(
+		jen.Op("*").Id("u").Op("=").Add(gi.returnType(outType)).Values(),
+		jen.Return(jen.Nil()),
+	)
+}
@@ -0,0 +1,94 @@
+package codegen
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// fieldTagKey is the struct tag key gunion reads per-variant options from.
+const fieldTagKey = "gunion"
+
+// fieldOptions holds the per-variant options parsed from a field's `gunion:"..."` struct tag.
+type fieldOptions struct {
+	// Explicit variant number set with id=N. Nil if the field doesn't pin its number.
+	id *int
+	// Name returned by String() for the variant, set with name=N. Empty to use the field name.
+	name string
+	// Tag identifying the variant in JSON and SQL, set with json=T. Empty to use the field name.
+	json string
+	// Whether to skip the variant's Is_, Unwrap_, Get_, Try_ and Ptr_ methods.
+	noGetters bool
+	// Whether the variant is numbered 0, making it the zero value of the union.
+	isDefault bool
+	// Whether the variant's generated API is marked deprecated, and the message to mark it with.
+	deprecated  bool
+	deprecation string
+}
+
+// parseFieldOptions parses the gunion struct tag of a source field.
+// Options are comma-separated and take the form key=value, or just key for flags.
+//
+//	circle float64 `gunion:"id=3,name=Circle,json=circ,nogetters,default,deprecated=use square"`
+func parseFieldOptions(tag string) (fieldOptions, error) {
+	var opts fieldOptions
+	value, ok := reflect.StructTag(tag).Lookup(fieldTagKey)
+	if !ok || value == "" {
+		return opts, nil
+	}
+
+	for _, option := range strings.Split(value, ",") {
+		key, arg, hasArg := strings.Cut(strings.TrimSpace(option), "=")
+		switch key {
+		case "id":
+			id, err := strconv.Atoi(arg)
+			if err != nil || id < 0 {
+				return fieldOptions{}, fmt.Errorf("invalid id %q: must be a non-negative integer", arg)
+			}
+			opts.id = &id
+		case "name", "json":
+			if arg == "" {
+				return fieldOptions{}, fmt.Errorf("option %s requires a value", key)
+			}
+			if key == "name" {
+				opts.name = arg
+			} else {
+				opts.json = arg
+			}
+		case "nogetters", "default":
+			if hasArg {
+				return fieldOptions{}, fmt.Errorf("option %s takes no value", key)
+			}
+			if key == "nogetters" {
+				opts.noGetters = true
+			} else {
+				opts.isDefault = true
+			}
+		case "deprecated":
+			opts.deprecated = true
+			opts.deprecation = arg
+		default:
+			return fieldOptions{}, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	return opts, nil
+}
+
+// checkVariantNames returns an error if two variants would have the same String() name or the
+// same JSON and SQL tag, since they couldn't be told apart.
+func checkVariantNames(variants []variant) error {
+	names := make(map[string]string, len(variants))
+	tags := make(map[string]string, len(variants))
+	for _, v := range variants {
+		if other, ok := names[v.displayName()]; ok {
+			return fmt.Errorf("variants %s and %s are both named %s", other, v.name, v.displayName())
+		}
+		names[v.displayName()] = v.name
+		if other, ok := tags[v.wireTag()]; ok {
+			return fmt.Errorf("variants %s and %s both have json tag %s", other, v.name, v.wireTag())
+		}
+		tags[v.wireTag()] = v.name
+	}
+	return nil
+}
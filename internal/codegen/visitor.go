@@ -0,0 +1,57 @@
+package codegen
+
+import "github.com/dave/jennifer/jen"
+
+// generateVisitor generates a visitor interface with one method per variant, and an Accept
+// function that calls the method for the active variant. Unlike Match, a visitor is checked
+// by method name rather than argument position. Methods are ordered the same way as Match arms.
+//
+// For non-generic types:
+//
+//	type OutTypeVisitor[_R any] interface {
+//	    Visit_a(int) _R
+//	    Visit_Invalid() _R
+//	}
+//
+//	func Accept_OutType[_R any](u *OutType, v OutTypeVisitor[_R]) _R { ... }
+//
+// For generic types, the visitor also takes the union's type parameters:
+//
+//	type OutTypeVisitor[T any, U comparable, _R any] interface { ... }
+//
+//	func Accept_OutType[T any, U comparable, _R any](u *OutType[T, U], v OutTypeVisitor[T, U, _R]) _R { ... }
+func generateVisitor(variants []variant, outType string, sf *structFields, gi *genericsInfo, outFile *jen.File) {
+	ordered := matchOrder(variants)
+	resultParam := gi.resultParam()
+	visitorName := sf.names.union(nameVisitor)
+
+	var methods []jen.Code
+	var cases []jen.Code
+	for _, v := range ordered {
+		methodName := sf.names.variant(nameVisit, v)
+		if v.hasPayload() {
+			methods = append(methods, jen.Id(methodName).Params(v.argTypes()...).Id(resultParam))
+			cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+				jen.Return(jen.Id("v").Dot(methodName).Call(sf.payloadArgs("u", v)...)),
+			))
+		} else {
+			methods = append(methods, jen.Id(methodName).Params().Id(resultParam))
+			cases = append(cases, jen.Case(jen.Id(v.constName)).Block(
+				jen.Return(jen.Id("v").Dot(methodName).Call()),
+			))
+		}
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Panic(jen.Lit("unreachable")),
+	))
+
+	outFile.Type().Id(visitorName).Types(gi.resultTypeParams()...).Interface(methods...).Line()
+
+	acceptFuncName := sf.names.union(nameAccept)
+	outFile.Func().Id(acceptFuncName).Types(gi.resultTypeParams()...).Params(
+		gi.receiverType(outType),
+		jen.Id("v").Id(visitorName).Types(gi.resultTypeArgs()...),
+	).Id(resultParam).Block(
+		jen.Switch(jen.Id("u").Dot(sf.variantField)).Block(cases...),
+	).Line()
+}
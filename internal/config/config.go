@@ -1,17 +1,92 @@
 package config
 
+// JSONStyle selects how the generated MarshalJSON/UnmarshalJSON methods tag the active variant.
+type JSONStyle string
+
+const (
+	// JSONNone disables JSON method generation.
+	JSONNone JSONStyle = ""
+	// JSONExternal encodes a union as {"<variant>": <payload>}.
+	JSONExternal JSONStyle = "external"
+	// JSONAdjacent encodes a union as {"type": "<variant>", "value": <payload>}.
+	JSONAdjacent JSONStyle = "adjacent"
+	// JSONInternal encodes a union as the payload object with an added "type" key.
+	// Only payloads that encode to JSON objects are supported.
+	JSONInternal JSONStyle = "internal"
+)
+
+// SQLEncoding selects how the generated Scan/Value methods store a union with database/sql.
+type SQLEncoding string
+
+const (
+	// SQLNone disables database/sql method generation.
+	SQLNone SQLEncoding = ""
+	// SQLJSON stores a union in a single column, using its JSON encoding.
+	SQLJSON SQLEncoding = "json"
+	// SQLColumns stores the variant name in one column and the JSON payload in another.
+	SQLColumns SQLEncoding = "columns"
+)
+
+// NamingScheme selects how generated identifiers such as Is_circle or IsCircle are named.
+type NamingScheme string
+
+const (
+	// NamingUnderscore joins a prefix and the variant with an underscore, as in Is_circle and
+	// NewShape_circle. An empty scheme means the same.
+	NamingUnderscore NamingScheme = "underscore"
+	// NamingCamel capitalizes the variant and joins it directly, as in IsCircle and NewShapeCircle.
+	NamingCamel NamingScheme = "camel"
+)
+
 type OutputConfig struct {
 	OutType string
 	OutFile string
 	OutPkg  string
-	Command string
-	Getters bool
-	Setters bool
-	Match   bool
+	// Import path of the output package. If empty or the same as the source type's package, the union
+	// is generated into the source package; otherwise it only refers to exported source identifiers.
+	OutPkgPath string
+	Command    string
+	Getters    bool
+	Setters    bool
+	Match      bool
+	// Generate a MatchE function whose arms return (R, error).
+	MatchE bool
+	// Generate a Switch function whose arms return nothing.
+	Switch bool
+	// Generate a MatchOr function taking a subset of arms and a fallback.
+	MatchOr bool
+	// Generate a visitor interface and Accept function.
+	Visitor bool
 	Default bool
+	// Generate an exported kind type, a Kind method and a Variants function.
+	Kind bool
+	JSON JSONStyle
+	// Fail generation if variant numbers differ from those in the existing output file.
+	StableVariants bool
+	// Generate an interface with one type per variant instead of a union struct.
+	Sealed bool
+	// Store the payload as an any instead of embedding the source struct.
+	Compact bool
+	// Generate an Equal method comparing the variants and active payloads of two unions.
+	Equal bool
+	// Generate String, GoString and Format methods printing only the active variant.
+	Format bool
+	// Generate a LogValue method logging only the active variant with log/slog.
+	Slog bool
+	// Encoding used for database/sql Scan/Value methods. SQLNone means none are generated.
+	SQL SQLEncoding
+	// Naming scheme of the generated identifiers.
+	Naming NamingScheme
+	// Templates overriding the naming scheme for some kinds of identifier, keyed by kind, such as "is".
+	NameTemplates map[string]string
 }
 
 type InputConfig struct {
 	Source string
-	Type   string
+	// Names of the types to load from the source file's package, in order.
+	// If empty, types are discovered from //gunion:union directives instead.
+	Types []string
+	// Package patterns to search for directives, such as "./...".
+	// If empty, the source file's package is searched.
+	Patterns []string
 }
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// fileNames are the names of project config files, in the order they are looked for in each directory.
+var fileNames = []string{"gunion.yaml", ".gunion.yaml", "gunion.toml", ".gunion.toml"}
+
+// File is a project config file, setting options for every union generated from the packages below it.
+// Options are named after the flags and given as key=value, the same way as in //gunion:union directives.
+//
+//	defaults:
+//	  no-default: true
+//	  naming: camel
+//	types:
+//	  shape:
+//	    out-type: Shape
+//	    json: adjacent
+type File struct {
+	// Path of the file.
+	Path string
+	// Options applied to every type.
+	Defaults []string
+	// Options applied to the types with the given names, after the defaults.
+	Types map[string][]string
+}
+
+// fileContent is the content of a config file before its options are converted to key=value form.
+type fileContent struct {
+	Defaults map[string]any            `yaml:"defaults" toml:"defaults"`
+	Types    map[string]map[string]any `yaml:"types" toml:"types"`
+}
+
+// FindFile looks for a config file in dir and its parents, stopping at the module root, the first directory
+// containing a go.mod file. It returns false if there is none.
+func FindFile(dir string) (File, bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return File{}, false, err
+	}
+	for {
+		var found []string
+		for _, name := range fileNames {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				found = append(found, path)
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return File{}, false, err
+			}
+		}
+		switch len(found) {
+		case 0:
+		case 1:
+			f, err := ReadFile(found[0])
+			return f, err == nil, err
+		default:
+			return File{}, false, fmt.Errorf("found more than one config file: %s and %s", found[0], found[1])
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return File{}, false, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return File{}, false, nil
+		}
+		dir = parent
+	}
+}
+
+// ReadFile reads a YAML or TOML config file, depending on its extension.
+func ReadFile(path string) (File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var content fileContent
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		// An empty file decodes to io.EOF, and sets nothing.
+		if err := dec.Decode(&content); err != nil && len(bytes.TrimSpace(data)) > 0 {
+			return File{}, fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+	case ".toml":
+		md, err := toml.Decode(string(data), &content)
+		if err != nil {
+			return File{}, fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+		// Keys of inline tables within options are reported too, so only sections are checked.
+		for _, key := range md.Undecoded() {
+			if len(key) == 1 {
+				return File{}, fmt.Errorf("invalid config file %s: unknown key %s", path, key)
+			}
+		}
+	default:
+		return File{}, fmt.Errorf("unsupported config file extension %s", ext)
+	}
+
+	f := File{Path: path, Types: make(map[string][]string, len(content.Types))}
+	if f.Defaults, err = fileOptions(content.Defaults); err != nil {
+		return File{}, fmt.Errorf("invalid config file %s: defaults: %w", path, err)
+	}
+	for typeName, values := range content.Types {
+		if f.Types[typeName], err = fileOptions(values); err != nil {
+			return File{}, fmt.Errorf("invalid config file %s: type %s: %w", path, typeName, err)
+		}
+	}
+	return f, nil
+}
+
+// fileOptions converts the options of a config file section to key=value form, sorted by key. Maps, used
+// for name-template, become one option per entry: name-template=is=Has{Variant}.
+func fileOptions(values map[string]any) ([]string, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var options []string
+	for _, key := range keys {
+		switch value := values[key].(type) {
+		case map[string]any:
+			entries := make([]string, 0, len(value))
+			for entryKey, entryValue := range value {
+				s, err := optionValue(entryValue)
+				if err != nil {
+					return nil, fmt.Errorf("option %s.%s: %w", key, entryKey, err)
+				}
+				entries = append(entries, key+"="+entryKey+"="+s)
+			}
+			sort.Strings(entries)
+			options = append(options, entries...)
+		default:
+			s, err := optionValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("option %s: %w", key, err)
+			}
+			options = append(options, key+"="+s)
+		}
+	}
+	return options, nil
+}
+
+// optionValue formats a scalar option value.
+func optionValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("expected a string or boolean, got %T", value)
+	}
+}
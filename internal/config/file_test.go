@@ -0,0 +1,149 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sidkurella/gunion/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFile writes content to path, creating its directory.
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestReadFile(t *testing.T) {
+	expected := func(path string) config.File {
+		return config.File{
+			Path:     path,
+			Defaults: []string{"naming=camel", "no-default=true"},
+			Types: map[string][]string{
+				"shape": {"json=adjacent", "name-template=get=Find{Variant}", "name-template=is=Has{Variant}"},
+			},
+		}
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "gunion.yaml")
+		writeFile(t, path, `
+defaults:
+  no-default: true
+  naming: camel
+types:
+  shape:
+    json: adjacent
+    name-template:
+      is: Has{Variant}
+      get: Find{Variant}
+`)
+		f, err := config.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected(path), f)
+	})
+
+	t.Run("toml", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), ".gunion.toml")
+		writeFile(t, path, `
+[defaults]
+no-default = true
+naming = "camel"
+
+[types.shape]
+json = "adjacent"
+name-template = { is = "Has{Variant}", get = "Find{Variant}" }
+`)
+		f, err := config.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected(path), f)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "gunion.yaml")
+		writeFile(t, path, "")
+		f, err := config.ReadFile(path)
+		require.NoError(t, err)
+		assert.Empty(t, f.Defaults)
+		assert.Empty(t, f.Types)
+	})
+
+	errorCases := []struct {
+		name     string
+		file     string
+		content  string
+		outError string
+	}{
+		{
+			name:     "unknown yaml section",
+			file:     "gunion.yaml",
+			content:  "options:\n  kind: true\n",
+			outError: "field options not found in type config.fileContent",
+		},
+		{
+			name:     "unknown toml section",
+			file:     "gunion.toml",
+			content:  "[options]\nkind = true\n",
+			outError: "unknown key options",
+		},
+		{
+			name:     "non-scalar value",
+			file:     "gunion.yaml",
+			content:  "defaults:\n  kind: [true]\n",
+			outError: "defaults: option kind: expected a string or boolean, got []interface {}",
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			writeFile(t, path, tc.content)
+			_, err := config.ReadFile(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.outError)
+		})
+	}
+}
+
+func TestFindFile(t *testing.T) {
+	t.Run("nearest file above the directory", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, "go.mod"), "module example.com/m\n")
+		writeFile(t, filepath.Join(root, "gunion.yaml"), "defaults:\n  kind: true\n")
+		writeFile(t, filepath.Join(root, "a", ".gunion.toml"), "[defaults]\nequal = true\n")
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "a", "b"), 0755))
+
+		f, ok, err := config.FindFile(filepath.Join(root, "a", "b"))
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, filepath.Join(root, "a", ".gunion.toml"), f.Path)
+
+		f, ok, err = config.FindFile(root)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, []string{"kind=true"}, f.Defaults)
+	})
+
+	t.Run("stops at the module root", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, "gunion.yaml"), "defaults:\n  kind: true\n")
+		writeFile(t, filepath.Join(root, "mod", "go.mod"), "module example.com/m\n")
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "mod", "pkg"), 0755))
+
+		_, ok, err := config.FindFile(filepath.Join(root, "mod", "pkg"))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("more than one file in a directory", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, "gunion.yaml"), "")
+		writeFile(t, filepath.Join(root, ".gunion.toml"), "")
+
+		_, _, err := config.FindFile(root)
+		assert.EqualError(t, err, "found more than one config file: "+
+			filepath.Join(root, "gunion.yaml")+" and "+filepath.Join(root, ".gunion.toml"))
+	})
+}
@@ -0,0 +1,129 @@
+package loader
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/sidkurella/gunion/internal/types"
+	"golang.org/x/tools/go/packages"
+)
+
+// directivePrefix marks a type to generate a union from. Options follow on the same line:
+//
+//	//gunion:union out=Shape no-default
+//	type shape struct { ... }
+const directivePrefix = "//gunion:union"
+
+// Directive is a type marked with a //gunion:union comment.
+type Directive struct {
+	Named types.Named
+	// Absolute path of the file that declares the type.
+	File string
+	// Name of the package that declares the type.
+	PkgName string
+	// Options given after the marker, split on whitespace, e.g. ["out=Shape", "no-default"].
+	Options []string
+}
+
+// Discover loads the configured packages and returns every type marked with a directive,
+// ordered by package path and then by position within the package.
+// If no patterns are configured, the source file's package is searched, or else the current directory.
+func (l *Loader) Discover() ([]Directive, error) {
+	patterns := l.config.Patterns
+	if len(patterns) == 0 {
+		if l.config.Source != "" {
+			patterns = []string{"file=" + l.config.Source}
+		} else {
+			patterns = []string{"."}
+		}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: loadMode}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages %s: %w", strings.Join(patterns, " "), err)
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no packages matched %s", strings.Join(patterns, " "))
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].PkgPath < pkgs[j].PkgPath
+	})
+
+	var ret []Directive
+	for _, pkg := range pkgs {
+		if err := checkPackageErrors(pkg); err != nil {
+			return nil, err
+		}
+		found, err := packageDirectives(pkg)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, found...)
+	}
+	return ret, nil
+}
+
+// packageDirectives finds the marked types declared in the package's syntax trees.
+func packageDirectives(pkg *packages.Package) ([]Directive, error) {
+	var ret []Directive
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				// An ungrouped declaration's doc comment is attached to the GenDecl, not the TypeSpec.
+				doc := typeSpec.Doc
+				if !genDecl.Lparen.IsValid() {
+					doc = genDecl.Doc
+				}
+				options, ok, err := parseDirective(doc)
+				if err != nil {
+					return nil, fmt.Errorf("type %s: %w", typeSpec.Name.Name, err)
+				}
+				if !ok {
+					continue
+				}
+
+				named, err := lookupNamed(pkg, typeSpec.Name.Name)
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, Directive{
+					Named:   named,
+					File:    pkg.Fset.Position(typeSpec.Pos()).Filename,
+					PkgName: pkg.Name,
+					Options: options,
+				})
+			}
+		}
+	}
+	return ret, nil
+}
+
+// parseDirective returns the options of the directive in the doc comment, and whether there was one.
+func parseDirective(doc *ast.CommentGroup) ([]string, bool, error) {
+	if doc == nil {
+		return nil, false, nil
+	}
+	var options []string
+	found := false
+	for _, comment := range doc.List {
+		rest, ok := strings.CutPrefix(comment.Text, directivePrefix)
+		// Require a separator so that e.g. //gunion:unions is not mistaken for the directive.
+		if !ok || (rest != "" && rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+		if found {
+			return nil, false, fmt.Errorf("more than one %s directive", directivePrefix)
+		}
+		found = true
+		options = strings.Fields(rest)
+	}
+	return options, found, nil
+}
@@ -0,0 +1,140 @@
+package loader
+
+import (
+	"fmt"
+	"go/ast"
+	gotypes "go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Errors in a package are tolerated unless they affect a type being loaded. In particular, a stale
+// generated file referring to variants that no longer exist must not prevent regenerating it. The
+// file isn't left out of the package, because the source struct may refer to the union it declares.
+
+// checkPackageErrors returns an error if the package couldn't be loaded at all, or if it has errors
+// whose position is unknown, so that they can't be attributed to a declaration.
+func checkPackageErrors(pkg *packages.Package) error {
+	for _, e := range pkg.Errors {
+		if isCompilerOutput(pkg, e) {
+			continue
+		}
+		if _, _, ok := errorPosition(e); e.Kind == packages.ListError || !ok {
+			return fmt.Errorf("package %s had errors: %v", pkg.PkgPath, pkg.Errors)
+		}
+	}
+	return nil
+}
+
+// checkDeclErrors returns an error if the declaration of the type named by obj has errors, or if
+// its definition refers to an invalid type, such as one from a package that failed to import.
+func checkDeclErrors(pkg *packages.Package, obj *gotypes.TypeName) error {
+	if len(pkg.Errors) == 0 {
+		return nil
+	}
+	declErr := fmt.Errorf("type %s in package %s had errors: %v", obj.Name(), pkg.PkgPath, pkg.Errors)
+	if containsInvalid(obj.Type().Underlying(), make(map[gotypes.Type]bool)) {
+		return declErr
+	}
+
+	spec := typeSpec(pkg, obj)
+	if spec == nil {
+		return declErr
+	}
+	start, end := pkg.Fset.Position(spec.Pos()), pkg.Fset.Position(spec.End())
+	for _, e := range pkg.Errors {
+		file, line, _ := errorPosition(e)
+		if file == start.Filename && line >= start.Line && line <= end.Line {
+			return declErr
+		}
+	}
+	return nil
+}
+
+// typeSpec finds the syntax of the declaration of obj.
+func typeSpec(pkg *packages.Package, obj *gotypes.TypeName) *ast.TypeSpec {
+	var found *ast.TypeSpec
+	for _, file := range pkg.Syntax {
+		if file.FileStart > obj.Pos() || obj.Pos() > file.FileEnd {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.TypeSpec); ok && spec.Name.Pos() == obj.Pos() {
+				found = spec
+			}
+			return found == nil
+		})
+	}
+	return found
+}
+
+// isCompilerOutput reports whether e is the compiler output of the go command for the package, which
+// starts with "# <package path>". The same errors are also reported by the type checker, with positions.
+func isCompilerOutput(pkg *packages.Package, e packages.Error) bool {
+	return e.Kind == packages.ListError && strings.HasPrefix(e.Msg, "# "+pkg.PkgPath+"\n")
+}
+
+// errorPosRegexp matches the position of a packages.Error: file:line or file:line:column.
+var errorPosRegexp = regexp.MustCompile(`^(.+):(\d+)(?::\d+)?$`)
+
+// errorPosition returns the file and line an error was reported at, if known.
+func errorPosition(e packages.Error) (string, int, bool) {
+	m := errorPosRegexp.FindStringSubmatch(e.Pos)
+	if m == nil {
+		return "", 0, false
+	}
+	line, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", 0, false
+	}
+	return m[1], line, true
+}
+
+// containsInvalid reports whether t is or is built from the invalid type, which the type checker
+// substitutes for types it couldn't resolve. Named types are only checked for their type arguments,
+// since their own definitions are checked where they are declared.
+func containsInvalid(t gotypes.Type, seen map[gotypes.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch typ := gotypes.Unalias(t).(type) {
+	case *gotypes.Basic:
+		return typ.Kind() == gotypes.Invalid
+	case *gotypes.Pointer:
+		return containsInvalid(typ.Elem(), seen)
+	case *gotypes.Slice:
+		return containsInvalid(typ.Elem(), seen)
+	case *gotypes.Array:
+		return containsInvalid(typ.Elem(), seen)
+	case *gotypes.Chan:
+		return containsInvalid(typ.Elem(), seen)
+	case *gotypes.Map:
+		return containsInvalid(typ.Key(), seen) || containsInvalid(typ.Elem(), seen)
+	case *gotypes.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if containsInvalid(typ.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	case *gotypes.Signature:
+		return containsInvalid(typ.Params(), seen) || containsInvalid(typ.Results(), seen)
+	case *gotypes.Tuple:
+		for i := 0; i < typ.Len(); i++ {
+			if containsInvalid(typ.At(i).Type(), seen) {
+				return true
+			}
+		}
+	case *gotypes.Named:
+		for i := 0; i < typ.TypeArgs().Len(); i++ {
+			if containsInvalid(typ.TypeArgs().At(i), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package loader
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// LoadPackages always loads the types from the type-checked package, skipping the syntax-only path.
+func (l *Loader) LoadPackages() ([]types.Named, error) {
+	return l.loadPackages()
+}
+
+// LoadSyntax loads the types from the package's syntax only, reporting false if that isn't possible.
+func (l *Loader) LoadSyntax() ([]types.Named, bool) {
+	return l.loadSyntax()
+}
@@ -20,34 +20,67 @@ func NewLoader(config config.InputConfig) *Loader {
 	}
 }
 
-func (l *Loader) Load() (types.Named, error) {
-	pkgs, err := packages.Load(&packages.Config{
-		// Probably overkill, but it works and is simpler than trying to figure out exactly which flags we need.
-		Mode: packages.NeedTypes | packages.NeedImports | packages.NeedSyntax | packages.NeedTypesInfo |
-			packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedModule,
-	}, "file="+l.config.Source)
+// Probably overkill, but it works and is simpler than trying to figure out exactly which flags we need.
+// NeedDeps is required with NeedImports and NeedTypes: without it, some versions of go/packages fail to
+// type-check packages with imports, reporting that an imported package has no types.
+const loadMode = packages.NeedTypes | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax |
+	packages.NeedTypesInfo | packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedModule
+
+// Load loads every configured type from the package containing the source file.
+// Types that only use predeclared types are read from the package's syntax; otherwise the package is
+// loaded and type-checked, only once no matter how many types are requested.
+func (l *Loader) Load() ([]types.Named, error) {
+	if ret, ok := l.loadSyntax(); ok {
+		return ret, nil
+	}
+	return l.loadPackages()
+}
+
+// loadPackages loads every configured type from the type-checked package containing the source file.
+func (l *Loader) loadPackages() ([]types.Named, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: loadMode}, "file="+l.config.Source)
 	if err != nil {
-		return types.Named{}, fmt.Errorf("failed to load packages for source file %s: %w", l.config.Source, err)
+		return nil, fmt.Errorf("failed to load packages for source file %s: %w", l.config.Source, err)
 	}
 
 	if len(pkgs) != 1 {
-		return types.Named{}, fmt.Errorf("expected to load 1 package but got %d", len(pkgs))
+		return nil, fmt.Errorf("expected to load 1 package but got %d", len(pkgs))
 	}
 
 	pkg := pkgs[0]
 
-	if len(pkg.Errors) > 0 {
-		return types.Named{}, fmt.Errorf("package %s had errors: %v", pkg.PkgPath, pkg.Errors)
+	if err := checkPackageErrors(pkg); err != nil {
+		return nil, err
+	}
+
+	ret := make([]types.Named, 0, len(l.config.Types))
+	for _, typeName := range l.config.Types {
+		named, err := lookupNamed(pkg, typeName)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, named)
 	}
+	return ret, nil
+}
 
-	obj := pkg.Types.Scope().Lookup(l.config.Type)
+// lookupNamed finds the named type with the given name in the package scope and parses it.
+func lookupNamed(pkg *packages.Package, typeName string) (types.Named, error) {
+	obj := pkg.Types.Scope().Lookup(typeName)
 	if obj == nil {
-		return types.Named{}, fmt.Errorf("could not find type %s in package", l.config.Type)
+		if len(pkg.Errors) > 0 {
+			// The type is likely missing because of the errors, such as a syntax error in its file.
+			return types.Named{}, fmt.Errorf("package %s had errors: %v", pkg.PkgPath, pkg.Errors)
+		}
+		return types.Named{}, fmt.Errorf("could not find type %s in package", typeName)
 	}
 
 	namedType, ok := obj.Type().(*gotypes.Named)
 	if !ok {
-		return types.Named{}, fmt.Errorf("type %s must be a named type, but it was not", l.config.Type)
+		return types.Named{}, fmt.Errorf("type %s must be a named type, but it was not", typeName)
+	}
+	if err := checkDeclErrors(pkg, namedType.Obj()); err != nil {
+		return types.Named{}, err
 	}
 
 	return parseNamedWithDepth(namedType, true)
@@ -332,16 +365,24 @@ func parseSlice(t *gotypes.Slice) (types.Slice, error) {
 
 func parseAlias(t *gotypes.Alias) (types.Named, error) {
 	// Type aliases (e.g., `type any = interface{}`) are represented as Named types.
-	// We don't expand the underlying type to avoid issues with built-in aliases.
+	// We don't expand the underlying type to avoid issues with built-in aliases, but record how values of
+	// the aliased type are compared, the same as for named types.
 	name := t.Obj().Name()
 	var pkg string
 	if t.Obj().Pkg() != nil {
 		pkg = t.Obj().Pkg().Path()
 	}
-	return types.Named{
-		Name:    name,
-		Package: pkg,
-	}, nil
+	target := gotypes.Unalias(t)
+	ret := types.Named{
+		Name:         name,
+		Package:      pkg,
+		Incomparable: !gotypes.Comparable(target),
+		Interface:    gotypes.IsInterface(target),
+	}
+	if named, ok := target.(*gotypes.Named); ok {
+		ret.HasEqual = hasEqualMethod(named)
+	}
+	return ret, nil
 }
 
 func parseBasic(t *gotypes.Basic) (types.Basic, error) {
@@ -382,13 +423,34 @@ func parseNamedWithDepth(t *gotypes.Named, expandUnderlying bool) (types.Named,
 		pkg = t.Obj().Pkg().Path()
 	}
 
-	return types.Named{
+	ret := types.Named{
 		Name:       name,
 		Package:    pkg,
 		Type:       underlyingType,
 		TypeParams: typeParams,
 		TypeArgs:   typeArgs,
-	}, nil
+	}
+	if !expandUnderlying {
+		ret.Incomparable = !gotypes.Comparable(t)
+		ret.Interface = gotypes.IsInterface(t)
+		ret.HasEqual = hasEqualMethod(t)
+	}
+	return ret, nil
+}
+
+// hasEqualMethod reports whether t, or a pointer to it, has a method Equal(t) bool.
+func hasEqualMethod(t *gotypes.Named) bool {
+	obj, _, _ := gotypes.LookupFieldOrMethod(t, true, t.Obj().Pkg(), "Equal")
+	method, ok := obj.(*gotypes.Func)
+	if !ok {
+		return false
+	}
+	sig := method.Signature()
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	result, ok := sig.Results().At(0).Type().(*gotypes.Basic)
+	return ok && result.Kind() == gotypes.Bool && gotypes.Identical(sig.Params().At(0).Type(), t)
 }
 
 func parseTypeParamList(t *gotypes.TypeParamList) ([]types.TypeParam, error) {
@@ -1,16 +1,25 @@
 package loader_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/sidkurella/gunion/internal/config"
 	"github.com/sidkurella/gunion/internal/loader"
 	"github.com/sidkurella/gunion/internal/testdata/aliasedimport"
 	"github.com/sidkurella/gunion/internal/testdata/basic"
+	"github.com/sidkurella/gunion/internal/testdata/crosspkg"
+	"github.com/sidkurella/gunion/internal/testdata/directives"
 	"github.com/sidkurella/gunion/internal/testdata/externalimport"
 	"github.com/sidkurella/gunion/internal/testdata/generics"
 	"github.com/sidkurella/gunion/internal/testdata/imported"
+	"github.com/sidkurella/gunion/internal/testdata/jsoninternal"
+	"github.com/sidkurella/gunion/internal/testdata/multi"
+	"github.com/sidkurella/gunion/internal/testdata/pinned"
+	"github.com/sidkurella/gunion/internal/testdata/record"
 	"github.com/sidkurella/gunion/internal/testdata/torture"
+	"github.com/sidkurella/gunion/internal/testdata/unit"
 	"github.com/sidkurella/gunion/internal/types"
 	"github.com/stretchr/testify/require"
 )
@@ -27,7 +36,7 @@ func TestLoader(t *testing.T) {
 			name: "basic",
 			inConfig: config.InputConfig{
 				Source: "../testdata/basic/basic.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: basic.Representation,
 		},
@@ -35,7 +44,7 @@ func TestLoader(t *testing.T) {
 			name: "imported",
 			inConfig: config.InputConfig{
 				Source: "../testdata/imported/imported.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: imported.Representation,
 		},
@@ -43,7 +52,7 @@ func TestLoader(t *testing.T) {
 			name: "externalimport",
 			inConfig: config.InputConfig{
 				Source: "../testdata/externalimport/externalimport.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: externalimport.Representation,
 		},
@@ -51,7 +60,7 @@ func TestLoader(t *testing.T) {
 			name: "generics",
 			inConfig: config.InputConfig{
 				Source: "../testdata/generics/generics.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: generics.Representation,
 		},
@@ -59,7 +68,7 @@ func TestLoader(t *testing.T) {
 			name: "torture",
 			inConfig: config.InputConfig{
 				Source: "../testdata/torture/torture.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: torture.Representation,
 		},
@@ -67,10 +76,58 @@ func TestLoader(t *testing.T) {
 			name: "aliasedimport",
 			inConfig: config.InputConfig{
 				Source: "../testdata/aliasedimport/aliasedimport.go",
-				Type:   "myUnion",
+				Types:  []string{"myUnion"},
 			},
 			outNamed: aliasedimport.Representation,
 		},
+		{
+			name: "jsoninternal",
+			inConfig: config.InputConfig{
+				Source: "../testdata/jsoninternal/jsoninternal.go",
+				Types:  []string{"myUnion"},
+			},
+			outNamed: jsoninternal.Representation,
+		},
+		{
+			name: "pinned",
+			inConfig: config.InputConfig{
+				Source: "../testdata/pinned/pinned.go",
+				Types:  []string{"myUnion"},
+			},
+			outNamed: pinned.Representation,
+		},
+		{
+			name: "crosspkg",
+			inConfig: config.InputConfig{
+				Source: "../testdata/crosspkg/crosspkg.go",
+				Types:  []string{"myUnion"},
+			},
+			outNamed: crosspkg.Representation,
+		},
+		{
+			name: "crosspkg unexported type",
+			inConfig: config.InputConfig{
+				Source: "../testdata/crosspkg/crosspkg.go",
+				Types:  []string{"badUnion"},
+			},
+			outNamed: crosspkg.BadRepresentation,
+		},
+		{
+			name: "unit",
+			inConfig: config.InputConfig{
+				Source: "../testdata/unit/unit.go",
+				Types:  []string{"myUnion"},
+			},
+			outNamed: unit.Representation,
+		},
+		{
+			name: "record",
+			inConfig: config.InputConfig{
+				Source: "../testdata/record/record.go",
+				Types:  []string{"myUnion"},
+			},
+			outNamed: record.Representation,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -80,17 +137,204 @@ func TestLoader(t *testing.T) {
 				require.EqualError(t, err, tc.outError.Error())
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.outNamed, named)
+				require.Equal(t, []types.Named{tc.outNamed}, named)
+			}
+		})
+	}
+}
+
+func TestLoaderMultipleTypes(t *testing.T) {
+	l := loader.NewLoader(config.InputConfig{
+		Source: "../testdata/multi/multi.go",
+		Types:  []string{"otherUnion", "myUnion"},
+	})
+	named, err := l.Load()
+	require.NoError(t, err)
+	// Types are returned in the order they were requested.
+	require.Equal(t, []types.Named{multi.OtherRepresentation, multi.Representation}, named)
+}
+
+func TestLoaderDiscover(t *testing.T) {
+	srcAbs, err := filepath.Abs("../testdata/directives/directives.go")
+	require.NoError(t, err)
+	expected := []loader.Directive{
+		{
+			Named:   directives.ShapeRepresentation,
+			File:    srcAbs,
+			PkgName: "directives",
+			Options: []string{"out=Shape", "no-default"},
+		},
+		{
+			Named:   directives.ColorRepresentation,
+			File:    srcAbs,
+			PkgName: "directives",
+			Options: []string{},
+		},
+	}
+
+	t.Run("source file package", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{Source: "../testdata/directives/directives.go"})
+		found, err := l.Discover()
+		require.NoError(t, err)
+		require.Equal(t, expected, found)
+	})
+
+	t.Run("package patterns", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{
+			Patterns: []string{"../testdata/directives", "../testdata/basic"},
+		})
+		found, err := l.Discover()
+		require.NoError(t, err)
+		require.Equal(t, expected, found)
+	})
+
+	t.Run("more than one directive on a type", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{Patterns: []string{"../testdata/directiveerror"}})
+		_, err := l.Discover()
+		require.EqualError(t, err, "type myUnion: more than one //gunion:union directive")
+	})
+
+	t.Run("no matching packages", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{Patterns: []string{"../testdata/nonexistent/..."}})
+		_, err := l.Discover()
+		require.Error(t, err)
+	})
+}
+
+func TestLoaderSyntax(t *testing.T) {
+	type testcase struct {
+		name     string
+		inConfig config.InputConfig
+		// Whether the types can be loaded from syntax alone.
+		syntaxOnly bool
+	}
+	cases := []testcase{
+		{
+			name:       "basic",
+			inConfig:   config.InputConfig{Source: "../testdata/basic/basic.go", Types: []string{"myUnion"}},
+			syntaxOnly: true,
+		},
+		{
+			name:       "unit",
+			inConfig:   config.InputConfig{Source: "../testdata/unit/unit.go", Types: []string{"myUnion"}},
+			syntaxOnly: true,
+		},
+		{
+			name:       "pinned",
+			inConfig:   config.InputConfig{Source: "../testdata/pinned/pinned.go", Types: []string{"myUnion"}},
+			syntaxOnly: true,
+		},
+		{
+			name: "multiple types",
+			inConfig: config.InputConfig{
+				Source: "../testdata/multi/multi.go",
+				Types:  []string{"otherUnion", "myUnion"},
+			},
+			syntaxOnly: true,
+		},
+		{
+			name: "predeclared types",
+			inConfig: config.InputConfig{
+				Source: "../testdata/syntaxonly/syntaxonly.go",
+				Types:  []string{"myUnion"},
+			},
+			syntaxOnly: true,
+		},
+		{
+			name: "named field type",
+			inConfig: config.InputConfig{
+				Source: "../testdata/syntaxonly/syntaxonly.go",
+				Types:  []string{"namedUnion"},
+			},
+		},
+		{
+			name: "some types need type information",
+			inConfig: config.InputConfig{
+				Source: "../testdata/syntaxonly/syntaxonly.go",
+				Types:  []string{"myUnion", "namedUnion"},
+			},
+		},
+		{
+			name:     "type parameters",
+			inConfig: config.InputConfig{Source: "../testdata/record/record.go", Types: []string{"myUnion"}},
+		},
+		{
+			name:     "imported types",
+			inConfig: config.InputConfig{Source: "../testdata/torture/torture.go", Types: []string{"myUnion"}},
+		},
+		{
+			name:     "non-struct type",
+			inConfig: config.InputConfig{Source: "../testdata/nonstruct/nonstruct.go", Types: []string{"myUnion"}},
+		},
+		{
+			name:     "nonexistent type",
+			inConfig: config.InputConfig{Source: "../testdata/basic/basic.go", Types: []string{"doesNotExist"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := loader.NewLoader(tc.inConfig)
+			named, ok := l.LoadSyntax()
+			require.Equal(t, tc.syntaxOnly, ok)
+			if !ok {
+				return
 			}
+			// The syntax-only path must agree with the type checker.
+			expected, err := l.LoadPackages()
+			require.NoError(t, err)
+			require.Equal(t, expected, named)
 		})
 	}
+
+	t.Run("shadowed predeclared type", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/m\n"), 0644))
+		pkgDir := filepath.Join(dir, "pkg")
+		require.NoError(t, os.Mkdir(pkgDir, 0755))
+		src := filepath.Join(pkgDir, "pkg.go")
+		require.NoError(t, os.WriteFile(src, []byte("package pkg\n\ntype myUnion struct {\n\ta int\n}\n"), 0644))
+
+		l := loader.NewLoader(config.InputConfig{Source: src, Types: []string{"myUnion"}})
+		named, ok := l.LoadSyntax()
+		require.True(t, ok)
+		require.Equal(t, "example.com/m/pkg", named[0].Package)
+
+		// A declaration of int anywhere in the package changes what the field's type means.
+		other := []byte("package pkg\n\ntype int = string\n")
+		require.NoError(t, os.WriteFile(filepath.Join(pkgDir, "other.go"), other, 0644))
+		_, ok = l.LoadSyntax()
+		require.False(t, ok)
+	})
+}
+
+func BenchmarkLoad(b *testing.B) {
+	inConfig := config.InputConfig{
+		Source: "../testdata/basic/basic.go",
+		Types:  []string{"myUnion"},
+	}
+	b.Run("syntax", func(b *testing.B) {
+		l := loader.NewLoader(inConfig)
+		for b.Loop() {
+			if _, ok := l.LoadSyntax(); !ok {
+				b.Fatal("expected the syntax-only path to be taken")
+			}
+		}
+	})
+	b.Run("packages", func(b *testing.B) {
+		l := loader.NewLoader(inConfig)
+		for b.Loop() {
+			if _, err := l.LoadPackages(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
 }
 
 func TestLoaderErrors(t *testing.T) {
 	t.Run("nonexistent source file", func(t *testing.T) {
 		l := loader.NewLoader(config.InputConfig{
 			Source: "../testdata/nonexistent/nonexistent.go",
-			Type:   "myUnion",
+			Types:  []string{"myUnion"},
 		})
 		_, err := l.Load()
 		require.Error(t, err)
@@ -101,7 +345,7 @@ func TestLoaderErrors(t *testing.T) {
 	t.Run("nonexistent type in valid source", func(t *testing.T) {
 		l := loader.NewLoader(config.InputConfig{
 			Source: "../testdata/basic/basic.go",
-			Type:   "doesNotExist",
+			Types:  []string{"doesNotExist"},
 		})
 		_, err := l.Load()
 		require.Error(t, err)
@@ -111,19 +355,43 @@ func TestLoaderErrors(t *testing.T) {
 	t.Run("non-struct type", func(t *testing.T) {
 		l := loader.NewLoader(config.InputConfig{
 			Source: "../testdata/nonstruct/nonstruct.go",
-			Type:   "myUnion",
+			Types:  []string{"myUnion"},
 		})
 		// The loader should succeed — it loads any named type.
 		// The codegen layer is responsible for rejecting non-struct types.
 		named, err := l.Load()
 		require.NoError(t, err)
-		require.Equal(t, "myUnion", named.Name)
+		require.Len(t, named, 1)
+		require.Equal(t, "myUnion", named[0].Name)
+	})
+
+	t.Run("stale generated file is tolerated", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{
+			Source: "../testdata/stale/stale.go",
+			Types:  []string{"myUnion"},
+		})
+		named, err := l.Load()
+		require.NoError(t, err)
+		require.Len(t, named, 1)
+		fields := named[0].Type.(types.Struct).Fields
+		require.Len(t, fields, 2)
+		require.Equal(t, "c", fields[1].Name)
+	})
+
+	t.Run("field type from a broken import", func(t *testing.T) {
+		l := loader.NewLoader(config.InputConfig{
+			Source: "../testdata/brokenimport/brokenimport.go",
+			Types:  []string{"myUnion"},
+		})
+		_, err := l.Load()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "type myUnion in package github.com/sidkurella/gunion/internal/testdata/brokenimport had errors")
 	})
 
 	t.Run("source file with compile errors", func(t *testing.T) {
 		l := loader.NewLoader(config.InputConfig{
 			Source: "../testdata/compileerror/compileerror.go",
-			Type:   "myUnion",
+			Types:  []string{"myUnion"},
 		})
 		_, err := l.Load()
 		require.Error(t, err)
@@ -0,0 +1,289 @@
+package loader
+
+import (
+	"bufio"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sidkurella/gunion/internal/types"
+)
+
+// Loading a package with go/packages type-checks it along with all of its dependencies, which can take
+// seconds in a large module. Most unions only use predeclared types, though, and those can be read
+// straight from the syntax of the package, which is what loadSyntax does.
+
+// predeclaredBasics are the predeclared basic types, which can be resolved without type information.
+var predeclaredBasics = map[string]bool{
+	"bool": true, "string": true, "byte": true, "rune": true, "uintptr": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "complex64": true, "complex128": true,
+}
+
+// loadSyntax loads the configured types from the syntax of the source file's package, without
+// type-checking it. It reports false if any type can't be resolved that way, such as one with type
+// parameters or a field whose type is named, and then the package must be loaded with go/packages.
+func (l *Loader) loadSyntax() ([]types.Named, bool) {
+	dir := filepath.Dir(l.config.Source)
+	pkgPath, ok := importPath(dir)
+	if !ok {
+		return nil, false
+	}
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil || len(bp.CgoFiles) > 0 {
+		return nil, false
+	}
+
+	// Every file is parsed, since the types may be declared in any of them, and a package-level
+	// declaration would shadow a predeclared type.
+	fset := token.NewFileSet()
+	specs := make(map[string][]*ast.TypeSpec)
+	declared := make(map[string]bool)
+	foundSource := false
+	for _, name := range bp.GoFiles {
+		path := filepath.Join(dir, name)
+		if sameFile(path, l.config.Source) {
+			foundSource = true
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, false
+		}
+		for _, decl := range file.Decls {
+			for _, name := range declaredNames(decl) {
+				declared[name] = true
+			}
+			if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					specs[typeSpec.Name.Name] = append(specs[typeSpec.Name.Name], typeSpec)
+				}
+			}
+		}
+	}
+	if !foundSource {
+		return nil, false
+	}
+
+	r := syntaxResolver{declared: declared}
+	ret := make([]types.Named, 0, len(l.config.Types))
+	for _, typeName := range l.config.Types {
+		found := specs[typeName]
+		if len(found) != 1 || found[0].TypeParams != nil || found[0].Assign.IsValid() {
+			return nil, false
+		}
+		structType, ok := found[0].Type.(*ast.StructType)
+		if !ok {
+			return nil, false
+		}
+		typ, ok := r.resolveStruct(structType)
+		if !ok {
+			return nil, false
+		}
+		ret = append(ret, types.Named{
+			Name:    typeName,
+			Package: pkgPath,
+			Type:    typ,
+		})
+	}
+	return ret, true
+}
+
+// syntaxResolver converts type expressions to types, as long as they only use predeclared types.
+type syntaxResolver struct {
+	// Names declared at package level, which shadow predeclared types.
+	declared map[string]bool
+}
+
+func (r syntaxResolver) resolve(expr ast.Expr) (types.Type, bool) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.resolve(e.X)
+	case *ast.Ident:
+		if !predeclaredBasics[e.Name] || r.declared[e.Name] {
+			return nil, false
+		}
+		return types.Basic{Name: e.Name}, true
+	case *ast.StarExpr:
+		elem, ok := r.resolve(e.X)
+		return types.Pointer{Elem: elem}, ok
+	case *ast.ArrayType:
+		elem, ok := r.resolve(e.Elt)
+		if !ok {
+			return nil, false
+		}
+		if e.Len == nil {
+			return types.Slice{Elem: elem}, true
+		}
+		// Only literal lengths; named constants need type information.
+		lit, ok := e.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return nil, false
+		}
+		n, err := strconv.ParseInt(strings.ReplaceAll(lit.Value, "_", ""), 0, 64)
+		if err != nil {
+			return nil, false
+		}
+		return types.Array{Len: n, Elem: elem}, true
+	case *ast.MapType:
+		key, ok := r.resolve(e.Key)
+		if !ok || !syntaxComparable(key) {
+			return nil, false
+		}
+		value, ok := r.resolve(e.Value)
+		return types.Map{Key: key, Value: value}, ok
+	case *ast.ChanType:
+		elem, ok := r.resolve(e.Value)
+		dir := types.SendRecv
+		switch e.Dir {
+		case ast.SEND:
+			dir = types.SendOnly
+		case ast.RECV:
+			dir = types.RecvOnly
+		}
+		return types.Chan{Direction: dir, Elem: elem}, ok
+	case *ast.StructType:
+		return r.resolveStruct(e)
+	default:
+		return nil, false
+	}
+}
+
+func (r syntaxResolver) resolveStruct(s *ast.StructType) (types.Struct, bool) {
+	var fields []types.Field
+	seen := make(map[string]bool)
+	for _, field := range s.Fields.List {
+		// Embedded fields are named after their type, which is never predeclared here.
+		if len(field.Names) == 0 {
+			return types.Struct{}, false
+		}
+		typ, ok := r.resolve(field.Type)
+		if !ok {
+			return types.Struct{}, false
+		}
+		var tag string
+		if field.Tag != nil {
+			var err error
+			if tag, err = strconv.Unquote(field.Tag.Value); err != nil {
+				return types.Struct{}, false
+			}
+		}
+		for _, name := range field.Names {
+			if name.Name != "_" && seen[name.Name] {
+				return types.Struct{}, false
+			}
+			seen[name.Name] = true
+			fields = append(fields, types.Field{Var: types.Var{Name: name.Name, Type: typ}, Tag: tag})
+		}
+	}
+	return types.Struct{Fields: fields}, true
+}
+
+// syntaxComparable reports whether a type resolved from syntax can be compared, as map keys must be.
+func syntaxComparable(t types.Type) bool {
+	switch typ := t.(type) {
+	case types.Slice, types.Map:
+		return false
+	case types.Array:
+		return syntaxComparable(typ.Elem)
+	case types.Struct:
+		for _, f := range typ.Fields {
+			if !syntaxComparable(f.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// declaredNames returns the package-level names declared by decl.
+func declaredNames(decl ast.Decl) []string {
+	var names []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil {
+			names = append(names, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// importPath returns the import path of the package in dir, from the module path in the nearest go.mod.
+func importPath(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	for root := dir; ; {
+		if modPath, ok := modulePath(filepath.Join(root, "go.mod")); ok {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", false
+			}
+			if rel == "." {
+				return modPath, true
+			}
+			return modPath + "/" + filepath.ToSlash(rel), true
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", false
+		}
+		root = parent
+	}
+}
+
+// modulePath reads the module path declared in a go.mod file.
+func modulePath(goMod string) (string, bool) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		rest, ok := strings.CutPrefix(line, "module")
+		if !ok || rest == "" || (rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+		rest, _, _ = strings.Cut(rest, "//")
+		path := strings.TrimSpace(rest)
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			path = unquoted
+		}
+		return path, path != ""
+	}
+	return "", false
+}
+
+// sameFile reports whether the two paths refer to the same file.
+func sameFile(a, b string) bool {
+	infoA, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	infoB, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(infoA, infoB)
+}
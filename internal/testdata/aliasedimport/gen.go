@@ -4,6 +4,7 @@ package aliasedimport
 
 import (
 	"context"
+	gunion "github.com/sidkurella/gunion/gunion"
 	"io"
 )
 
@@ -44,13 +45,17 @@ func NewMyUnionUnion_Invalid() MyUnionUnion {
 	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion) Unwrap_a() context.Context {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -63,6 +68,21 @@ func (u *MyUnionUnion) Get_a() (context.Context, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_a() (context.Context, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero context.Context
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *context.Context {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a(val context.Context) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{a: val},
@@ -70,13 +90,20 @@ func NewMyUnionUnion_a(val context.Context) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_a(val context.Context) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion) Unwrap_b() io.Writer {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -89,6 +116,21 @@ func (u *MyUnionUnion) Get_b() (io.Writer, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_b() (io.Writer, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero io.Writer
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() *io.Writer {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b(val io.Writer) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{b: val},
@@ -96,13 +138,20 @@ func NewMyUnionUnion_b(val io.Writer) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_b(val io.Writer) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func (u *MyUnionUnion) Is_c() bool {
 	return u._variant == _myUnionVariant_c
 }
 
 func (u *MyUnionUnion) Unwrap_c() io.Reader {
 	if u._variant != _myUnionVariant_c {
-		panic("called Unwrap_c on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()})
 	}
 	return u._inner.c
 }
@@ -115,6 +164,21 @@ func (u *MyUnionUnion) Get_c() (io.Reader, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_c() (io.Reader, error) {
+	if u._variant != _myUnionVariant_c {
+		var zero io.Reader
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()}
+	}
+	return u._inner.c, nil
+}
+
+func (u *MyUnionUnion) Ptr_c() *io.Reader {
+	if u._variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u._inner.c
+}
+
 func NewMyUnionUnion_c(val io.Reader) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{c: val},
@@ -122,6 +186,13 @@ func NewMyUnionUnion_c(val io.Reader) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_c(val io.Reader) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
 func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(context.Context) _R, on_b func(io.Writer) _R, on_c func(io.Reader) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
@@ -13,9 +13,9 @@ var Representation = types.Named{
 	Package: "github.com/sidkurella/gunion/internal/testdata/aliasedimport",
 	Type: types.Struct{
 		Fields: []types.Field{
-			{Var: types.Var{Name: "a", Type: types.Named{Name: "Context", Package: "context"}}},
-			{Var: types.Var{Name: "b", Type: types.Named{Name: "Writer", Package: "io"}}},
-			{Var: types.Var{Name: "c", Type: types.Named{Name: "Reader", Package: "io"}}},
+			{Var: types.Var{Name: "a", Type: types.Named{Name: "Context", Package: "context", Interface: true}}},
+			{Var: types.Var{Name: "b", Type: types.Named{Name: "Writer", Package: "io", Interface: true}}},
+			{Var: types.Var{Name: "c", Type: types.Named{Name: "Reader", Package: "io", Interface: true}}},
 		},
 	},
 }
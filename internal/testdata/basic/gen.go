@@ -2,6 +2,8 @@
 
 package basic
 
+import gunion "github.com/sidkurella/gunion/gunion"
+
 type _myUnionVariant int
 
 const (
@@ -36,13 +38,17 @@ func NewMyUnionUnion_Invalid() MyUnionUnion {
 	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion) Unwrap_a() int {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -55,6 +61,21 @@ func (u *MyUnionUnion) Get_a() (int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a(val int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{a: val},
@@ -62,13 +83,20 @@ func NewMyUnionUnion_a(val int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_a(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion) Unwrap_b() string {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -81,6 +109,21 @@ func (u *MyUnionUnion) Get_b() (string, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_b() (string, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() *string {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b(val string) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{b: val},
@@ -88,6 +131,13 @@ func NewMyUnionUnion_b(val string) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_b(val string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(string) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
@@ -0,0 +1,152 @@
+// Code generated by gunion via `gunion --type myUnion --src source.go --no-default --name-template is=Has{Variant},constructor=Make{Type}{Variant}`. DO NOT EDIT.
+
+package basic
+
+import gunion "github.com/sidkurella/gunion/gunion"
+
+type _myUnionVariant int
+
+const (
+	_myUnionVariant_Invalid _myUnionVariant = 0
+	_myUnionVariant_a       _myUnionVariant = 1
+	_myUnionVariant_b       _myUnionVariant = 2
+)
+
+func (v _myUnionVariant) String() string {
+	switch v {
+	case _myUnionVariant_Invalid:
+		return "Invalid"
+	case _myUnionVariant_a:
+		return "a"
+	case _myUnionVariant_b:
+		return "b"
+	default:
+		return "unknown"
+	}
+}
+
+type MyUnionUnion struct {
+	_variant _myUnionVariant
+	_inner   myUnion
+}
+
+func (u *MyUnionUnion) HasInvalid() bool {
+	return u._variant == _myUnionVariant_Invalid
+}
+
+func MakeMyUnionUnionInvalid() MyUnionUnion {
+	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion) HasA() bool {
+	return u._variant == _myUnionVariant_a
+}
+
+func (u *MyUnionUnion) Unwrap_a() int {
+	if u._variant != _myUnionVariant_a {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
+	}
+	return u._inner.a
+}
+
+func (u *MyUnionUnion) Get_a() (int, bool) {
+	if u._variant == _myUnionVariant_a {
+		return u._inner.a, true
+	}
+	var zero int
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
+func MakeMyUnionUnionA(val int) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
+func (u *MyUnionUnion) Set_a(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
+func (u *MyUnionUnion) HasB() bool {
+	return u._variant == _myUnionVariant_b
+}
+
+func (u *MyUnionUnion) Unwrap_b() string {
+	if u._variant != _myUnionVariant_b {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
+	}
+	return u._inner.b
+}
+
+func (u *MyUnionUnion) Get_b() (string, bool) {
+	if u._variant == _myUnionVariant_b {
+		return u._inner.b, true
+	}
+	var zero string
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_b() (string, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() *string {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
+func MakeMyUnionUnionB(val string) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
+func (u *MyUnionUnion) Set_b(val string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
+func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(string) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _myUnionVariant_a:
+		return on_a(u._inner.a)
+	case _myUnionVariant_b:
+		return on_b(u._inner.b)
+	case _myUnionVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
@@ -0,0 +1,8 @@
+package brokenimport
+
+import "github.com/sidkurella/gunion/internal/testdata/doesnotexist"
+
+type myUnion struct {
+	a doesnotexist.Thing
+	b string
+}
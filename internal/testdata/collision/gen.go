@@ -2,6 +2,8 @@
 
 package collision
 
+import gunion "github.com/sidkurella/gunion/gunion"
+
 type _myUnionVariant int
 
 const (
@@ -42,13 +44,17 @@ func NewMyUnionUnion__Invalid() MyUnionUnion {
 	return MyUnionUnion{__variant: _myUnionVariant__Invalid}
 }
 
+func (u *MyUnionUnion) Set__Invalid() {
+	*u = MyUnionUnion{__variant: _myUnionVariant__Invalid}
+}
+
 func (u *MyUnionUnion) Is__variant() bool {
 	return u.__variant == _myUnionVariant__variant
 }
 
 func (u *MyUnionUnion) Unwrap__variant() int {
 	if u.__variant != _myUnionVariant__variant {
-		panic("called Unwrap__variant on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "_variant", Got: u.__variant.String()})
 	}
 	return u.__inner._variant
 }
@@ -61,6 +67,21 @@ func (u *MyUnionUnion) Get__variant() (int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try__variant() (int, error) {
+	if u.__variant != _myUnionVariant__variant {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "_variant", Got: u.__variant.String()}
+	}
+	return u.__inner._variant, nil
+}
+
+func (u *MyUnionUnion) Ptr__variant() *int {
+	if u.__variant != _myUnionVariant__variant {
+		return nil
+	}
+	return &u.__inner._variant
+}
+
 func NewMyUnionUnion__variant(val int) MyUnionUnion {
 	return MyUnionUnion{
 		__inner:   myUnion{_variant: val},
@@ -68,13 +89,20 @@ func NewMyUnionUnion__variant(val int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set__variant(val int) {
+	*u = MyUnionUnion{
+		__inner:   myUnion{_variant: val},
+		__variant: _myUnionVariant__variant,
+	}
+}
+
 func (u *MyUnionUnion) Is__inner() bool {
 	return u.__variant == _myUnionVariant__inner
 }
 
 func (u *MyUnionUnion) Unwrap__inner() string {
 	if u.__variant != _myUnionVariant__inner {
-		panic("called Unwrap__inner on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "_inner", Got: u.__variant.String()})
 	}
 	return u.__inner._inner
 }
@@ -87,6 +115,21 @@ func (u *MyUnionUnion) Get__inner() (string, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try__inner() (string, error) {
+	if u.__variant != _myUnionVariant__inner {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "_inner", Got: u.__variant.String()}
+	}
+	return u.__inner._inner, nil
+}
+
+func (u *MyUnionUnion) Ptr__inner() *string {
+	if u.__variant != _myUnionVariant__inner {
+		return nil
+	}
+	return &u.__inner._inner
+}
+
 func NewMyUnionUnion__inner(val string) MyUnionUnion {
 	return MyUnionUnion{
 		__inner:   myUnion{_inner: val},
@@ -94,13 +137,20 @@ func NewMyUnionUnion__inner(val string) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set__inner(val string) {
+	*u = MyUnionUnion{
+		__inner:   myUnion{_inner: val},
+		__variant: _myUnionVariant__inner,
+	}
+}
+
 func (u *MyUnionUnion) Is_Invalid() bool {
 	return u.__variant == _myUnionVariant_Invalid
 }
 
 func (u *MyUnionUnion) Unwrap_Invalid() bool {
 	if u.__variant != _myUnionVariant_Invalid {
-		panic("called Unwrap_Invalid on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "Invalid", Got: u.__variant.String()})
 	}
 	return u.__inner.Invalid
 }
@@ -113,6 +163,21 @@ func (u *MyUnionUnion) Get_Invalid() (bool, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_Invalid() (bool, error) {
+	if u.__variant != _myUnionVariant_Invalid {
+		var zero bool
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "Invalid", Got: u.__variant.String()}
+	}
+	return u.__inner.Invalid, nil
+}
+
+func (u *MyUnionUnion) Ptr_Invalid() *bool {
+	if u.__variant != _myUnionVariant_Invalid {
+		return nil
+	}
+	return &u.__inner.Invalid
+}
+
 func NewMyUnionUnion_Invalid(val bool) MyUnionUnion {
 	return MyUnionUnion{
 		__inner:   myUnion{Invalid: val},
@@ -120,13 +185,20 @@ func NewMyUnionUnion_Invalid(val bool) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_Invalid(val bool) {
+	*u = MyUnionUnion{
+		__inner:   myUnion{Invalid: val},
+		__variant: _myUnionVariant_Invalid,
+	}
+}
+
 func (u *MyUnionUnion) Is_c() bool {
 	return u.__variant == _myUnionVariant_c
 }
 
 func (u *MyUnionUnion) Unwrap_c() float64 {
 	if u.__variant != _myUnionVariant_c {
-		panic("called Unwrap_c on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u.__variant.String()})
 	}
 	return u.__inner.c
 }
@@ -139,6 +211,21 @@ func (u *MyUnionUnion) Get_c() (float64, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_c() (float64, error) {
+	if u.__variant != _myUnionVariant_c {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u.__variant.String()}
+	}
+	return u.__inner.c, nil
+}
+
+func (u *MyUnionUnion) Ptr_c() *float64 {
+	if u.__variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u.__inner.c
+}
+
 func NewMyUnionUnion_c(val float64) MyUnionUnion {
 	return MyUnionUnion{
 		__inner:   myUnion{c: val},
@@ -146,6 +233,13 @@ func NewMyUnionUnion_c(val float64) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_c(val float64) {
+	*u = MyUnionUnion{
+		__inner:   myUnion{c: val},
+		__variant: _myUnionVariant_c,
+	}
+}
+
 func Match_MyUnionUnion[_R any](u *MyUnionUnion, on__variant func(int) _R, on__inner func(string) _R, on_Invalid func(bool) _R, on_c func(float64) _R, on__Invalid func() _R) _R {
 	switch u.__variant {
 	case _myUnionVariant__variant:
@@ -0,0 +1,7 @@
+package configfile
+
+// myUnion is generated with the options of the gunion.yaml file next to it.
+type myUnion struct {
+	circle float64
+	square float64
+}
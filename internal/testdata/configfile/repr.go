@@ -0,0 +1,15 @@
+package configfile
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/configfile",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "circle", Type: types.Basic{Name: "float64"}}},
+			{Var: types.Var{Name: "square", Type: types.Basic{Name: "float64"}}},
+		},
+	},
+}
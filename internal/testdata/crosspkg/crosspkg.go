@@ -0,0 +1,22 @@
+package crosspkg
+
+import "fmt"
+
+// Point is exported, so unions of myUnion can be generated into other packages.
+type Point struct {
+	X, Y int
+}
+
+type myUnion[T fmt.Stringer] struct {
+	a int
+	b Point
+	c []*T
+	d map[string]error
+}
+
+type secret struct{}
+
+type badUnion struct {
+	a int
+	b secret
+}
@@ -0,0 +1,269 @@
+// Code generated by gunion via `gunion --type myUnion --src source.go --no-default --out-pkg-path github.com/sidkurella/gunion/internal/testdata/crosspkg/out`. DO NOT EDIT.
+
+package out
+
+import (
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+	crosspkg "github.com/sidkurella/gunion/internal/testdata/crosspkg"
+)
+
+type _myUnionVariant int
+
+const (
+	_myUnionVariant_Invalid _myUnionVariant = 0
+	_myUnionVariant_a       _myUnionVariant = 1
+	_myUnionVariant_b       _myUnionVariant = 2
+	_myUnionVariant_c       _myUnionVariant = 3
+	_myUnionVariant_d       _myUnionVariant = 4
+)
+
+func (v _myUnionVariant) String() string {
+	switch v {
+	case _myUnionVariant_Invalid:
+		return "Invalid"
+	case _myUnionVariant_a:
+		return "a"
+	case _myUnionVariant_b:
+		return "b"
+	case _myUnionVariant_c:
+		return "c"
+	case _myUnionVariant_d:
+		return "d"
+	default:
+		return "unknown"
+	}
+}
+
+type _myUnionInner[T fmt.Stringer] struct {
+	a int
+	b crosspkg.Point
+	c []*T
+	d map[string]error
+}
+
+type MyUnionUnion[T fmt.Stringer] struct {
+	_variant _myUnionVariant
+	_inner   _myUnionInner[T]
+}
+
+func (u *MyUnionUnion[T]) Is_Invalid() bool {
+	return u._variant == _myUnionVariant_Invalid
+}
+
+func NewMyUnionUnion_Invalid[T fmt.Stringer]() MyUnionUnion[T] {
+	return MyUnionUnion[T]{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion[T]) Set_Invalid() {
+	*u = MyUnionUnion[T]{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion[T]) Is_a() bool {
+	return u._variant == _myUnionVariant_a
+}
+
+func (u *MyUnionUnion[T]) Unwrap_a() int {
+	if u._variant != _myUnionVariant_a {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
+	}
+	return u._inner.a
+}
+
+func (u *MyUnionUnion[T]) Get_a() (int, bool) {
+	if u._variant == _myUnionVariant_a {
+		return u._inner.a, true
+	}
+	var zero int
+	return zero, false
+}
+
+func (u *MyUnionUnion[T]) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion[T]) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
+func NewMyUnionUnion_a[T fmt.Stringer](val int) MyUnionUnion[T] {
+	return MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
+func (u *MyUnionUnion[T]) Set_a(val int) {
+	*u = MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
+func (u *MyUnionUnion[T]) Is_b() bool {
+	return u._variant == _myUnionVariant_b
+}
+
+func (u *MyUnionUnion[T]) Unwrap_b() crosspkg.Point {
+	if u._variant != _myUnionVariant_b {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
+	}
+	return u._inner.b
+}
+
+func (u *MyUnionUnion[T]) Get_b() (crosspkg.Point, bool) {
+	if u._variant == _myUnionVariant_b {
+		return u._inner.b, true
+	}
+	var zero crosspkg.Point
+	return zero, false
+}
+
+func (u *MyUnionUnion[T]) Try_b() (crosspkg.Point, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero crosspkg.Point
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion[T]) Ptr_b() *crosspkg.Point {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
+func NewMyUnionUnion_b[T fmt.Stringer](val crosspkg.Point) MyUnionUnion[T] {
+	return MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
+func (u *MyUnionUnion[T]) Set_b(val crosspkg.Point) {
+	*u = MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
+func (u *MyUnionUnion[T]) Is_c() bool {
+	return u._variant == _myUnionVariant_c
+}
+
+func (u *MyUnionUnion[T]) Unwrap_c() []*T {
+	if u._variant != _myUnionVariant_c {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()})
+	}
+	return u._inner.c
+}
+
+func (u *MyUnionUnion[T]) Get_c() ([]*T, bool) {
+	if u._variant == _myUnionVariant_c {
+		return u._inner.c, true
+	}
+	var zero []*T
+	return zero, false
+}
+
+func (u *MyUnionUnion[T]) Try_c() ([]*T, error) {
+	if u._variant != _myUnionVariant_c {
+		var zero []*T
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()}
+	}
+	return u._inner.c, nil
+}
+
+func (u *MyUnionUnion[T]) Ptr_c() *[]*T {
+	if u._variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u._inner.c
+}
+
+func NewMyUnionUnion_c[T fmt.Stringer](val []*T) MyUnionUnion[T] {
+	return MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
+func (u *MyUnionUnion[T]) Set_c(val []*T) {
+	*u = MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
+func (u *MyUnionUnion[T]) Is_d() bool {
+	return u._variant == _myUnionVariant_d
+}
+
+func (u *MyUnionUnion[T]) Unwrap_d() map[string]error {
+	if u._variant != _myUnionVariant_d {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "d", Got: u._variant.String()})
+	}
+	return u._inner.d
+}
+
+func (u *MyUnionUnion[T]) Get_d() (map[string]error, bool) {
+	if u._variant == _myUnionVariant_d {
+		return u._inner.d, true
+	}
+	var zero map[string]error
+	return zero, false
+}
+
+func (u *MyUnionUnion[T]) Try_d() (map[string]error, error) {
+	if u._variant != _myUnionVariant_d {
+		var zero map[string]error
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "d", Got: u._variant.String()}
+	}
+	return u._inner.d, nil
+}
+
+func (u *MyUnionUnion[T]) Ptr_d() *map[string]error {
+	if u._variant != _myUnionVariant_d {
+		return nil
+	}
+	return &u._inner.d
+}
+
+func NewMyUnionUnion_d[T fmt.Stringer](val map[string]error) MyUnionUnion[T] {
+	return MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{d: val},
+		_variant: _myUnionVariant_d,
+	}
+}
+
+func (u *MyUnionUnion[T]) Set_d(val map[string]error) {
+	*u = MyUnionUnion[T]{
+		_inner:   _myUnionInner[T]{d: val},
+		_variant: _myUnionVariant_d,
+	}
+}
+
+func Match_MyUnionUnion[T fmt.Stringer, _R any](u *MyUnionUnion[T], on_a func(int) _R, on_b func(crosspkg.Point) _R, on_c func([]*T) _R, on_d func(map[string]error) _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _myUnionVariant_a:
+		return on_a(u._inner.a)
+	case _myUnionVariant_b:
+		return on_b(u._inner.b)
+	case _myUnionVariant_c:
+		return on_c(u._inner.c)
+	case _myUnionVariant_d:
+		return on_d(u._inner.d)
+	case _myUnionVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
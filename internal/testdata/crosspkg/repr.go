@@ -0,0 +1,46 @@
+package crosspkg
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/crosspkg",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
+			{Var: types.Var{
+				Name: "b",
+				Type: types.Named{Name: "Point", Package: "github.com/sidkurella/gunion/internal/testdata/crosspkg"},
+			}},
+			{Var: types.Var{
+				Name: "c",
+				Type: types.Slice{Elem: types.Pointer{
+					Elem: types.Named{Name: "T", Package: "github.com/sidkurella/gunion/internal/testdata/crosspkg"},
+				}},
+			}},
+			{Var: types.Var{
+				Name: "d",
+				Type: types.Map{Key: types.Basic{Name: "string"}, Value: types.Named{Name: "error", Interface: true}},
+			}},
+		},
+	},
+	TypeParams: []types.TypeParam{
+		{Name: "T", Constraint: types.Named{Name: "Stringer", Package: "fmt", Interface: true}},
+	},
+}
+
+// BadRepresentation is the parsed type representation of badUnion.
+var BadRepresentation = types.Named{
+	Name:    "badUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/crosspkg",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
+			{Var: types.Var{
+				Name: "b",
+				Type: types.Named{Name: "secret", Package: "github.com/sidkurella/gunion/internal/testdata/crosspkg"},
+			}},
+		},
+	},
+}
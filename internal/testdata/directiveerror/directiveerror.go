@@ -0,0 +1,9 @@
+package directiveerror
+
+// myUnion has two directives, which is ambiguous.
+//
+//gunion:union out=A
+//gunion:union out=B
+type myUnion struct {
+	a int
+}
@@ -0,0 +1,25 @@
+package directives
+
+// shape is generated with the options given in its directive.
+//
+//gunion:union out=Shape no-default
+type shape struct {
+	circle float64
+	square float64
+}
+
+// event has no directive, so it is skipped.
+type event struct {
+	click int
+	key   rune
+}
+
+type (
+	// color is marked inside a grouped declaration.
+	//
+	//gunion:union
+	color struct {
+		red  bool
+		blue bool
+	}
+)
@@ -0,0 +1,27 @@
+package directives
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// ShapeRepresentation is the parsed type representation of shape.
+var ShapeRepresentation = types.Named{
+	Name:    "shape",
+	Package: "github.com/sidkurella/gunion/internal/testdata/directives",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "circle", Type: types.Basic{Name: "float64"}}},
+			{Var: types.Var{Name: "square", Type: types.Basic{Name: "float64"}}},
+		},
+	},
+}
+
+// ColorRepresentation is the parsed type representation of color.
+var ColorRepresentation = types.Named{
+	Name:    "color",
+	Package: "github.com/sidkurella/gunion/internal/testdata/directives",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "red", Type: types.Basic{Name: "bool"}}},
+			{Var: types.Var{Name: "blue", Type: types.Basic{Name: "bool"}}},
+		},
+	},
+}
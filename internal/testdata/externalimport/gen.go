@@ -4,6 +4,7 @@ package externalimport
 
 import (
 	"context"
+	gunion "github.com/sidkurella/gunion/gunion"
 	packages "golang.org/x/tools/go/packages"
 )
 
@@ -44,13 +45,17 @@ func NewMyUnionUnion_Invalid() MyUnionUnion {
 	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion) Unwrap_a() int {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -63,6 +68,21 @@ func (u *MyUnionUnion) Get_a() (int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a(val int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{a: val},
@@ -70,13 +90,20 @@ func NewMyUnionUnion_a(val int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_a(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion) Unwrap_b() *packages.Package {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -89,6 +116,21 @@ func (u *MyUnionUnion) Get_b() (*packages.Package, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_b() (*packages.Package, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero *packages.Package
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() **packages.Package {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b(val *packages.Package) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{b: val},
@@ -96,13 +138,20 @@ func NewMyUnionUnion_b(val *packages.Package) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_b(val *packages.Package) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func (u *MyUnionUnion) Is_c() bool {
 	return u._variant == _myUnionVariant_c
 }
 
 func (u *MyUnionUnion) Unwrap_c() context.Context {
 	if u._variant != _myUnionVariant_c {
-		panic("called Unwrap_c on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()})
 	}
 	return u._inner.c
 }
@@ -115,6 +164,21 @@ func (u *MyUnionUnion) Get_c() (context.Context, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_c() (context.Context, error) {
+	if u._variant != _myUnionVariant_c {
+		var zero context.Context
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()}
+	}
+	return u._inner.c, nil
+}
+
+func (u *MyUnionUnion) Ptr_c() *context.Context {
+	if u._variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u._inner.c
+}
+
 func NewMyUnionUnion_c(val context.Context) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{c: val},
@@ -122,6 +186,13 @@ func NewMyUnionUnion_c(val context.Context) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_c(val context.Context) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
 func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(*packages.Package) _R, on_c func(context.Context) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
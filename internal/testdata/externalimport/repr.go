@@ -13,11 +13,15 @@ var Representation = types.Named{
 			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
 			{Var: types.Var{
 				Name: "b",
-				Type: types.Pointer{Elem: types.Named{Name: "Package", Package: "golang.org/x/tools/go/packages"}},
+				Type: types.Pointer{Elem: types.Named{
+					Name:         "Package",
+					Package:      "golang.org/x/tools/go/packages",
+					Incomparable: true,
+				}},
 			}},
 			{Var: types.Var{
 				Name: "c",
-				Type: types.Named{Name: "Context", Package: "context"},
+				Type: types.Named{Name: "Context", Package: "context", Interface: true},
 			}},
 		},
 	},
@@ -2,7 +2,10 @@
 
 package generics
 
-import "io"
+import (
+	gunion "github.com/sidkurella/gunion/gunion"
+	"io"
+)
 
 type _myUnionVariant int
 
@@ -41,13 +44,17 @@ func NewMyUnionUnion_Invalid[T any, U comparable, V io.Writer]() MyUnionUnion[T,
 	return MyUnionUnion[T, U, V]{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion[T, U, V]) Set_Invalid() {
+	*u = MyUnionUnion[T, U, V]{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion[T, U, V]) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion[T, U, V]) Unwrap_a() T {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -60,6 +67,21 @@ func (u *MyUnionUnion[T, U, V]) Get_a() (T, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion[T, U, V]) Try_a() (T, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero T
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion[T, U, V]) Ptr_a() *T {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a[T any, U comparable, V io.Writer](val T) MyUnionUnion[T, U, V] {
 	return MyUnionUnion[T, U, V]{
 		_inner:   myUnion[T, U, V]{a: val},
@@ -67,13 +89,20 @@ func NewMyUnionUnion_a[T any, U comparable, V io.Writer](val T) MyUnionUnion[T,
 	}
 }
 
+func (u *MyUnionUnion[T, U, V]) Set_a(val T) {
+	*u = MyUnionUnion[T, U, V]{
+		_inner:   myUnion[T, U, V]{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion[T, U, V]) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion[T, U, V]) Unwrap_b() U {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -86,6 +115,21 @@ func (u *MyUnionUnion[T, U, V]) Get_b() (U, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion[T, U, V]) Try_b() (U, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero U
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion[T, U, V]) Ptr_b() *U {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b[T any, U comparable, V io.Writer](val U) MyUnionUnion[T, U, V] {
 	return MyUnionUnion[T, U, V]{
 		_inner:   myUnion[T, U, V]{b: val},
@@ -93,13 +137,20 @@ func NewMyUnionUnion_b[T any, U comparable, V io.Writer](val U) MyUnionUnion[T,
 	}
 }
 
+func (u *MyUnionUnion[T, U, V]) Set_b(val U) {
+	*u = MyUnionUnion[T, U, V]{
+		_inner:   myUnion[T, U, V]{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func (u *MyUnionUnion[T, U, V]) Is_c() bool {
 	return u._variant == _myUnionVariant_c
 }
 
 func (u *MyUnionUnion[T, U, V]) Unwrap_c() V {
 	if u._variant != _myUnionVariant_c {
-		panic("called Unwrap_c on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()})
 	}
 	return u._inner.c
 }
@@ -112,6 +163,21 @@ func (u *MyUnionUnion[T, U, V]) Get_c() (V, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion[T, U, V]) Try_c() (V, error) {
+	if u._variant != _myUnionVariant_c {
+		var zero V
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()}
+	}
+	return u._inner.c, nil
+}
+
+func (u *MyUnionUnion[T, U, V]) Ptr_c() *V {
+	if u._variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u._inner.c
+}
+
 func NewMyUnionUnion_c[T any, U comparable, V io.Writer](val V) MyUnionUnion[T, U, V] {
 	return MyUnionUnion[T, U, V]{
 		_inner:   myUnion[T, U, V]{c: val},
@@ -119,6 +185,13 @@ func NewMyUnionUnion_c[T any, U comparable, V io.Writer](val V) MyUnionUnion[T,
 	}
 }
 
+func (u *MyUnionUnion[T, U, V]) Set_c(val V) {
+	*u = MyUnionUnion[T, U, V]{
+		_inner:   myUnion[T, U, V]{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
 func Match_MyUnionUnion[T any, U comparable, V io.Writer, _R any](u *MyUnionUnion[T, U, V], on_a func(T) _R, on_b func(U) _R, on_c func(V) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
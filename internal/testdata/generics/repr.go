@@ -14,8 +14,8 @@ var Representation = types.Named{
 		},
 	},
 	TypeParams: []types.TypeParam{
-		{Name: "T", Constraint: types.Named{Name: "any"}},        // `any` is a type alias for interface{}
-		{Name: "U", Constraint: types.Named{Name: "comparable"}}, // `comparable` is a built-in constraint
-		{Name: "V", Constraint: types.Named{Name: "Writer", Package: "io"}},
+		{Name: "T", Constraint: types.Named{Name: "any", Interface: true}},        // `any` is a type alias for interface{}
+		{Name: "U", Constraint: types.Named{Name: "comparable", Interface: true}}, // `comparable` is a built-in constraint
+		{Name: "V", Constraint: types.Named{Name: "Writer", Package: "io", Interface: true}},
 	},
 }
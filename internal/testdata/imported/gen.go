@@ -2,7 +2,10 @@
 
 package imported
 
-import inner "github.com/sidkurella/gunion/internal/testdata/imported/inner"
+import (
+	gunion "github.com/sidkurella/gunion/gunion"
+	inner "github.com/sidkurella/gunion/internal/testdata/imported/inner"
+)
 
 type _myUnionVariant int
 
@@ -38,13 +41,17 @@ func NewMyUnionUnion_Invalid() MyUnionUnion {
 	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion) Unwrap_a() int {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -57,6 +64,21 @@ func (u *MyUnionUnion) Get_a() (int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a(val int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{a: val},
@@ -64,13 +86,20 @@ func NewMyUnionUnion_a(val int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_a(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion) Unwrap_b() inner.MyValue {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -83,6 +112,21 @@ func (u *MyUnionUnion) Get_b() (inner.MyValue, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_b() (inner.MyValue, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero inner.MyValue
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() *inner.MyValue {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b(val inner.MyValue) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{b: val},
@@ -90,6 +134,13 @@ func NewMyUnionUnion_b(val inner.MyValue) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_b(val inner.MyValue) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(inner.MyValue) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
@@ -0,0 +1,15 @@
+package jsoninternal
+
+type circle struct {
+	Radius float64 `json:"radius"`
+}
+
+type rectangle struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+type myUnion struct {
+	circle    circle
+	rectangle rectangle
+}
@@ -0,0 +1,21 @@
+package jsoninternal
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/jsoninternal",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{
+				Name: "circle",
+				Type: types.Named{Name: "circle", Package: "github.com/sidkurella/gunion/internal/testdata/jsoninternal"},
+			}},
+			{Var: types.Var{
+				Name: "rectangle",
+				Type: types.Named{Name: "rectangle", Package: "github.com/sidkurella/gunion/internal/testdata/jsoninternal"},
+			}},
+		},
+	},
+}
@@ -0,0 +1,12 @@
+package multi
+
+// myUnion and otherUnion are generated together in one invocation.
+type myUnion struct {
+	a int
+	b string
+}
+
+type otherUnion struct {
+	x float64
+	y []byte
+}
@@ -0,0 +1,27 @@
+package multi
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/multi",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
+			{Var: types.Var{Name: "b", Type: types.Basic{Name: "string"}}},
+		},
+	},
+}
+
+// OtherRepresentation is the parsed type representation of otherUnion.
+var OtherRepresentation = types.Named{
+	Name:    "otherUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/multi",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "x", Type: types.Basic{Name: "float64"}}},
+			{Var: types.Var{Name: "y", Type: types.Slice{Elem: types.Basic{Name: "byte"}}}},
+		},
+	},
+}
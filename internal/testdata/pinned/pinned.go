@@ -0,0 +1,9 @@
+package pinned
+
+// myUnion pins its variant numbers, so reordering or inserting fields doesn't renumber them.
+type myUnion struct {
+	b string  `gunion:"id=2"`
+	c float64 `gunion:"id=3"`
+	a int     `gunion:"id=1"`
+	d bool    `gunion:"id=7"`
+}
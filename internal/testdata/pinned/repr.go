@@ -0,0 +1,17 @@
+package pinned
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/pinned",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "b", Type: types.Basic{Name: "string"}}, Tag: `gunion:"id=2"`},
+			{Var: types.Var{Name: "c", Type: types.Basic{Name: "float64"}}, Tag: `gunion:"id=3"`},
+			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}, Tag: `gunion:"id=1"`},
+			{Var: types.Var{Name: "d", Type: types.Basic{Name: "bool"}}, Tag: `gunion:"id=7"`},
+		},
+	},
+}
@@ -0,0 +1,10 @@
+package record
+
+type myUnion[T any] struct {
+	circle float64
+	rect   struct{ w, h float64 }
+	pair   struct {
+		first T
+		rest  []T
+	}
+}
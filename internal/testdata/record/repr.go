@@ -0,0 +1,37 @@
+package record
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/record",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "circle", Type: types.Basic{Name: "float64"}}},
+			{Var: types.Var{Name: "rect", Type: types.Struct{
+				Fields: []types.Field{
+					{Var: types.Var{Name: "w", Type: types.Basic{Name: "float64"}}},
+					{Var: types.Var{Name: "h", Type: types.Basic{Name: "float64"}}},
+				},
+			}}},
+			{Var: types.Var{Name: "pair", Type: types.Struct{
+				Fields: []types.Field{
+					{Var: types.Var{
+						Name: "first",
+						Type: types.Named{Name: "T", Package: "github.com/sidkurella/gunion/internal/testdata/record"},
+					}},
+					{Var: types.Var{
+						Name: "rest",
+						Type: types.Slice{
+							Elem: types.Named{Name: "T", Package: "github.com/sidkurella/gunion/internal/testdata/record"},
+						},
+					}},
+				},
+			}}},
+		},
+	},
+	TypeParams: []types.TypeParam{
+		{Name: "T", Constraint: types.Named{Name: "any", Interface: true}},
+	},
+}
@@ -0,0 +1,15 @@
+package shadow
+
+import "time"
+
+// myUnion has record fields named like the identifiers their types are written with.
+type myUnion struct {
+	at struct {
+		time time.Time
+		n    int
+	}
+	sized struct {
+		int  int
+		_int string
+	}
+}
@@ -0,0 +1,7 @@
+package stale
+
+// b was renamed to c since stale_gunion.go was generated.
+type myUnion struct {
+	a int
+	c string
+}
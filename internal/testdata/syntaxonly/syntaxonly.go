@@ -0,0 +1,27 @@
+package syntaxonly
+
+// myUnion only uses predeclared types, so it can be loaded without type-checking the package.
+type myUnion struct {
+	a    int
+	b, c string
+	d    *float64
+	e    [0x10]byte
+	f    []rune
+	g    map[[2]int]map[string]bool
+	h    chan<- uint8
+	i    <-chan (complex128)
+	j    struct {
+		x, y int32 `json:"coord"`
+	}
+	k struct{}
+}
+
+// namedUnion has a field of a named type, so it needs type information.
+type namedUnion struct {
+	a int
+	b point
+}
+
+type point struct {
+	x, y int
+}
@@ -0,0 +1,504 @@
+// Code generated by gunion via `gunion --type myUnion --src source.go --kind --format --slog --json external --sql columns`. DO NOT EDIT.
+
+package tagged
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	gunion "github.com/sidkurella/gunion/gunion"
+	"io"
+	"log/slog"
+)
+
+type _myUnionVariant int
+
+const (
+	_myUnionVariant_circle _myUnionVariant = 1
+	_myUnionVariant_square _myUnionVariant = 0
+	_myUnionVariant_legacy _myUnionVariant = 2
+	_myUnionVariant_secret _myUnionVariant = 3
+)
+
+func (v _myUnionVariant) String() string {
+	switch v {
+	case _myUnionVariant_circle:
+		return "Circle"
+	case _myUnionVariant_square:
+		return "square"
+	case _myUnionVariant_legacy:
+		return "legacy"
+	case _myUnionVariant_secret:
+		return "secret"
+	default:
+		return "unknown"
+	}
+}
+
+type MyUnionUnion struct {
+	_variant _myUnionVariant
+	_inner   myUnion
+}
+
+func (u *MyUnionUnion) Is_circle() bool {
+	return u._variant == _myUnionVariant_circle
+}
+
+func (u *MyUnionUnion) Unwrap_circle() float64 {
+	if u._variant != _myUnionVariant_circle {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "Circle", Got: u._variant.String()})
+	}
+	return u._inner.circle
+}
+
+func (u *MyUnionUnion) Get_circle() (float64, bool) {
+	if u._variant == _myUnionVariant_circle {
+		return u._inner.circle, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_circle() (float64, error) {
+	if u._variant != _myUnionVariant_circle {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "Circle", Got: u._variant.String()}
+	}
+	return u._inner.circle, nil
+}
+
+func (u *MyUnionUnion) Ptr_circle() *float64 {
+	if u._variant != _myUnionVariant_circle {
+		return nil
+	}
+	return &u._inner.circle
+}
+
+func NewMyUnionUnion_circle(val float64) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{circle: val},
+		_variant: _myUnionVariant_circle,
+	}
+}
+
+func (u *MyUnionUnion) Set_circle(val float64) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{circle: val},
+		_variant: _myUnionVariant_circle,
+	}
+}
+
+func (u *MyUnionUnion) Is_square() bool {
+	return u._variant == _myUnionVariant_square
+}
+
+func (u *MyUnionUnion) Unwrap_square() float64 {
+	if u._variant != _myUnionVariant_square {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "square", Got: u._variant.String()})
+	}
+	return u._inner.square
+}
+
+func (u *MyUnionUnion) Get_square() (float64, bool) {
+	if u._variant == _myUnionVariant_square {
+		return u._inner.square, true
+	}
+	var zero float64
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_square() (float64, error) {
+	if u._variant != _myUnionVariant_square {
+		var zero float64
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "square", Got: u._variant.String()}
+	}
+	return u._inner.square, nil
+}
+
+func (u *MyUnionUnion) Ptr_square() *float64 {
+	if u._variant != _myUnionVariant_square {
+		return nil
+	}
+	return &u._inner.square
+}
+
+func NewMyUnionUnion_square(val float64) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{square: val},
+		_variant: _myUnionVariant_square,
+	}
+}
+
+func (u *MyUnionUnion) Set_square(val float64) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{square: val},
+		_variant: _myUnionVariant_square,
+	}
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Is_legacy() bool {
+	return u._variant == _myUnionVariant_legacy
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Unwrap_legacy() int {
+	if u._variant != _myUnionVariant_legacy {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "legacy", Got: u._variant.String()})
+	}
+	return u._inner.legacy
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Get_legacy() (int, bool) {
+	if u._variant == _myUnionVariant_legacy {
+		return u._inner.legacy, true
+	}
+	var zero int
+	return zero, false
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Try_legacy() (int, error) {
+	if u._variant != _myUnionVariant_legacy {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "legacy", Got: u._variant.String()}
+	}
+	return u._inner.legacy, nil
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Ptr_legacy() *int {
+	if u._variant != _myUnionVariant_legacy {
+		return nil
+	}
+	return &u._inner.legacy
+}
+
+// Deprecated: use square instead
+func NewMyUnionUnion_legacy(val int) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{legacy: val},
+		_variant: _myUnionVariant_legacy,
+	}
+}
+
+// Deprecated: use square instead
+func (u *MyUnionUnion) Set_legacy(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{legacy: val},
+		_variant: _myUnionVariant_legacy,
+	}
+}
+
+func NewMyUnionUnion_secret(val string) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{secret: val},
+		_variant: _myUnionVariant_secret,
+	}
+}
+
+func (u *MyUnionUnion) Set_secret(val string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{secret: val},
+		_variant: _myUnionVariant_secret,
+	}
+}
+
+func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_circle func(float64) _R, on_square func(float64) _R, on_legacy func(int) _R, on_secret func(string) _R) _R {
+	switch u._variant {
+	case _myUnionVariant_circle:
+		return on_circle(u._inner.circle)
+	case _myUnionVariant_square:
+		return on_square(u._inner.square)
+	case _myUnionVariant_legacy:
+		return on_legacy(u._inner.legacy)
+	case _myUnionVariant_secret:
+		return on_secret(u._inner.secret)
+	default:
+		panic("unreachable")
+	}
+}
+
+type MyUnionUnionKind int
+
+const (
+	MyUnionUnionKindCircle MyUnionUnionKind = 1
+	MyUnionUnionKindSquare MyUnionUnionKind = 0
+	// Deprecated: use square instead
+	MyUnionUnionKindLegacy MyUnionUnionKind = 2
+	MyUnionUnionKindSecret MyUnionUnionKind = 3
+)
+
+func (k MyUnionUnionKind) String() string {
+	return _myUnionVariant(k).String()
+}
+
+func (u *MyUnionUnion) Kind() MyUnionUnionKind {
+	return MyUnionUnionKind(u._variant)
+}
+
+func Variants_MyUnionUnion() []MyUnionUnionKind {
+	return []MyUnionUnionKind{MyUnionUnionKindCircle, MyUnionUnionKindSquare, MyUnionUnionKindLegacy, MyUnionUnionKindSecret}
+}
+
+func (u MyUnionUnion) String() string {
+	return fmt.Sprint(u)
+}
+
+func (u MyUnionUnion) GoString() string {
+	switch u._variant {
+	case _myUnionVariant_circle:
+		return fmt.Sprintf("tagged.NewMyUnionUnion_circle(%#v)", u._inner.circle)
+	case _myUnionVariant_square:
+		return fmt.Sprintf("tagged.NewMyUnionUnion_square(%#v)", u._inner.square)
+	case _myUnionVariant_legacy:
+		return fmt.Sprintf("tagged.NewMyUnionUnion_legacy(%#v)", u._inner.legacy)
+	case _myUnionVariant_secret:
+		return fmt.Sprintf("tagged.NewMyUnionUnion_secret(%#v)", u._inner.secret)
+	default:
+		return fmt.Sprintf("tagged.MyUnionUnion(%s)", u._variant)
+	}
+}
+
+func (u MyUnionUnion) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, u.GoString())
+		return
+	}
+	if verb != 'v' && verb != 's' {
+		fmt.Fprintf(f, "%%!%c(MyUnionUnion=%s)", verb, u.String())
+		return
+	}
+	format := "%v"
+	if f.Flag('+') {
+		format = "%+v"
+	}
+	switch u._variant {
+	case _myUnionVariant_circle:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "MyUnionUnion", "Circle", u._inner.circle)
+	case _myUnionVariant_square:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "MyUnionUnion", "square", u._inner.square)
+	case _myUnionVariant_legacy:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "MyUnionUnion", "legacy", u._inner.legacy)
+	case _myUnionVariant_secret:
+		fmt.Fprintf(f, "%s(%s: "+format+")", "MyUnionUnion", "secret", u._inner.secret)
+	default:
+		fmt.Fprintf(f, "MyUnionUnion(%s)", u._variant)
+	}
+}
+
+func (u MyUnionUnion) LogValue() slog.Value {
+	switch u._variant {
+	case _myUnionVariant_circle:
+		return slog.GroupValue(slog.String("variant", "Circle"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.circle).Resolve()})
+	case _myUnionVariant_square:
+		return slog.GroupValue(slog.String("variant", "square"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.square).Resolve()})
+	case _myUnionVariant_legacy:
+		return slog.GroupValue(slog.String("variant", "legacy"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.legacy).Resolve()})
+	case _myUnionVariant_secret:
+		return slog.GroupValue(slog.String("variant", "secret"), slog.Attr{Key: "value", Value: slog.AnyValue(u._inner.secret).Resolve()})
+	default:
+		return slog.GroupValue(slog.String("variant", u._variant.String()))
+	}
+}
+
+func (u MyUnionUnion) Value() (driver.Value, error) {
+	switch u._variant {
+	case _myUnionVariant_circle:
+		return "circ", nil
+	case _myUnionVariant_square:
+		return "square", nil
+	case _myUnionVariant_legacy:
+		return "legacy", nil
+	case _myUnionVariant_secret:
+		return "secret", nil
+	default:
+		return nil, fmt.Errorf("cannot store MyUnionUnion: unknown variant %s", u._variant)
+	}
+}
+
+func (u *MyUnionUnion) Scan(src any) error {
+	var tag string
+	switch src := src.(type) {
+	case nil:
+		return fmt.Errorf("cannot scan NULL into MyUnionUnion")
+	case string:
+		tag = src
+	case []byte:
+		tag = string(src)
+	default:
+		return fmt.Errorf("cannot scan %T into MyUnionUnion", src)
+	}
+	switch tag {
+	case "circ":
+		*u = MyUnionUnion{_variant: _myUnionVariant_circle}
+	case "square":
+		*u = MyUnionUnion{_variant: _myUnionVariant_square}
+	case "legacy":
+		*u = MyUnionUnion{_variant: _myUnionVariant_legacy}
+	case "secret":
+		*u = MyUnionUnion{_variant: _myUnionVariant_secret}
+	default:
+		return fmt.Errorf("cannot scan MyUnionUnion: unknown variant %q", tag)
+	}
+	return nil
+}
+
+// MyUnionUnionPayload stores the active payload of a MyUnionUnion as JSON, in the column after its variant.
+type MyUnionUnionPayload struct {
+	u *MyUnionUnion
+}
+
+func (u *MyUnionUnion) Payload() MyUnionUnionPayload {
+	return MyUnionUnionPayload{u: u}
+}
+
+func (p MyUnionUnionPayload) Value() (driver.Value, error) {
+	var value any
+	switch p.u._variant {
+	case _myUnionVariant_circle:
+		value = p.u._inner.circle
+	case _myUnionVariant_square:
+		value = p.u._inner.square
+	case _myUnionVariant_legacy:
+		value = p.u._inner.legacy
+	case _myUnionVariant_secret:
+		value = p.u._inner.secret
+	default:
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot store MyUnionUnion payload: %w", err)
+	}
+	return string(data), nil
+}
+
+func (p MyUnionUnionPayload) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+	case string:
+		data = []byte(src)
+	case []byte:
+		data = src
+	default:
+		return fmt.Errorf("cannot scan %T into MyUnionUnion payload", src)
+	}
+	switch p.u._variant {
+	case _myUnionVariant_circle:
+		var val float64
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan MyUnionUnion variant circle: %w", err)
+		}
+		*p.u = MyUnionUnion{
+			_inner:   myUnion{circle: val},
+			_variant: _myUnionVariant_circle,
+		}
+	case _myUnionVariant_square:
+		var val float64
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan MyUnionUnion variant square: %w", err)
+		}
+		*p.u = MyUnionUnion{
+			_inner:   myUnion{square: val},
+			_variant: _myUnionVariant_square,
+		}
+	case _myUnionVariant_legacy:
+		var val int
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan MyUnionUnion variant legacy: %w", err)
+		}
+		*p.u = MyUnionUnion{
+			_inner:   myUnion{legacy: val},
+			_variant: _myUnionVariant_legacy,
+		}
+	case _myUnionVariant_secret:
+		var val string
+		if err := json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("cannot scan MyUnionUnion variant secret: %w", err)
+		}
+		*p.u = MyUnionUnion{
+			_inner:   myUnion{secret: val},
+			_variant: _myUnionVariant_secret,
+		}
+	}
+	return nil
+}
+
+func (u MyUnionUnion) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _myUnionVariant_circle:
+		tag, value = "circ", u._inner.circle
+	case _myUnionVariant_square:
+		tag, value = "square", u._inner.square
+	case _myUnionVariant_legacy:
+		tag, value = "legacy", u._inner.legacy
+	case _myUnionVariant_secret:
+		tag, value = "secret", u._inner.secret
+	default:
+		return nil, fmt.Errorf("cannot marshal MyUnionUnion: variant %s has no JSON representation", u._variant)
+	}
+	return json.Marshal(map[string]any{tag: value})
+}
+
+func (u *MyUnionUnion) UnmarshalJSON(data []byte) error {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: %w", err)
+	}
+	if len(envelope) != 1 {
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: expected exactly one variant key, got %d", len(envelope))
+	}
+	var tag string
+	var payload json.RawMessage
+	for tag, payload = range envelope {
+	}
+	switch tag {
+	case "circ":
+		var val float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal MyUnionUnion variant circ: %w", err)
+		}
+		*u = MyUnionUnion{
+			_inner:   myUnion{circle: val},
+			_variant: _myUnionVariant_circle,
+		}
+		return nil
+	case "square":
+		var val float64
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal MyUnionUnion variant square: %w", err)
+		}
+		*u = MyUnionUnion{
+			_inner:   myUnion{square: val},
+			_variant: _myUnionVariant_square,
+		}
+		return nil
+	case "legacy":
+		var val int
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal MyUnionUnion variant legacy: %w", err)
+		}
+		*u = MyUnionUnion{
+			_inner:   myUnion{legacy: val},
+			_variant: _myUnionVariant_legacy,
+		}
+		return nil
+	case "secret":
+		var val string
+		if err := json.Unmarshal(payload, &val); err != nil {
+			return fmt.Errorf("cannot unmarshal MyUnionUnion variant secret: %w", err)
+		}
+		*u = MyUnionUnion{
+			_inner:   myUnion{secret: val},
+			_variant: _myUnionVariant_secret,
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: unknown variant %q", tag)
+	}
+}
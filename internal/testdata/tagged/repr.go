@@ -0,0 +1,17 @@
+package tagged
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/tagged",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "circle", Type: types.Basic{Name: "float64"}}, Tag: `gunion:"name=Circle,json=circ"`},
+			{Var: types.Var{Name: "square", Type: types.Basic{Name: "float64"}}, Tag: `gunion:"default"`},
+			{Var: types.Var{Name: "legacy", Type: types.Basic{Name: "int"}}, Tag: `gunion:"deprecated=use square instead"`},
+			{Var: types.Var{Name: "secret", Type: types.Basic{Name: "string"}}, Tag: `gunion:"nogetters"`},
+		},
+	},
+}
@@ -0,0 +1,9 @@
+package tagged
+
+// myUnion configures its variants with gunion struct tags.
+type myUnion struct {
+	circle float64 `gunion:"name=Circle,json=circ"`
+	square float64 `gunion:"default"`
+	legacy int     `gunion:"deprecated=use square instead"`
+	secret string  `gunion:"nogetters"`
+}
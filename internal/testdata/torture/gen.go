@@ -4,6 +4,7 @@ package torture
 
 import (
 	"context"
+	gunion "github.com/sidkurella/gunion/gunion"
 	"io"
 	"time"
 )
@@ -54,6 +55,8 @@ const (
 	_myUnionVariant_nn      _myUnionVariant = 40
 	_myUnionVariant_oo      _myUnionVariant = 41
 	_myUnionVariant_pp      _myUnionVariant = 42
+	_myUnionVariant_qq      _myUnionVariant = 43
+	_myUnionVariant_rr      _myUnionVariant = 44
 )
 
 func (v _myUnionVariant) String() string {
@@ -144,6 +147,10 @@ func (v _myUnionVariant) String() string {
 		return "oo"
 	case _myUnionVariant_pp:
 		return "pp"
+	case _myUnionVariant_qq:
+		return "qq"
+	case _myUnionVariant_rr:
+		return "rr"
 	default:
 		return "unknown"
 	}
@@ -162,13 +169,17 @@ func NewMyUnionUnion_Invalid() MyUnionUnion {
 	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
 }
 
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
 func (u *MyUnionUnion) Is_a() bool {
 	return u._variant == _myUnionVariant_a
 }
 
 func (u *MyUnionUnion) Unwrap_a() int {
 	if u._variant != _myUnionVariant_a {
-		panic("called Unwrap_a on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()})
 	}
 	return u._inner.a
 }
@@ -181,6 +192,21 @@ func (u *MyUnionUnion) Get_a() (int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_a() (int, error) {
+	if u._variant != _myUnionVariant_a {
+		var zero int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "a", Got: u._variant.String()}
+	}
+	return u._inner.a, nil
+}
+
+func (u *MyUnionUnion) Ptr_a() *int {
+	if u._variant != _myUnionVariant_a {
+		return nil
+	}
+	return &u._inner.a
+}
+
 func NewMyUnionUnion_a(val int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{a: val},
@@ -188,13 +214,20 @@ func NewMyUnionUnion_a(val int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_a(val int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{a: val},
+		_variant: _myUnionVariant_a,
+	}
+}
+
 func (u *MyUnionUnion) Is_b() bool {
 	return u._variant == _myUnionVariant_b
 }
 
 func (u *MyUnionUnion) Unwrap_b() string {
 	if u._variant != _myUnionVariant_b {
-		panic("called Unwrap_b on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()})
 	}
 	return u._inner.b
 }
@@ -207,6 +240,21 @@ func (u *MyUnionUnion) Get_b() (string, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_b() (string, error) {
+	if u._variant != _myUnionVariant_b {
+		var zero string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "b", Got: u._variant.String()}
+	}
+	return u._inner.b, nil
+}
+
+func (u *MyUnionUnion) Ptr_b() *string {
+	if u._variant != _myUnionVariant_b {
+		return nil
+	}
+	return &u._inner.b
+}
+
 func NewMyUnionUnion_b(val string) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{b: val},
@@ -214,13 +262,20 @@ func NewMyUnionUnion_b(val string) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_b(val string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{b: val},
+		_variant: _myUnionVariant_b,
+	}
+}
+
 func (u *MyUnionUnion) Is_c() bool {
 	return u._variant == _myUnionVariant_c
 }
 
 func (u *MyUnionUnion) Unwrap_c() *float64 {
 	if u._variant != _myUnionVariant_c {
-		panic("called Unwrap_c on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()})
 	}
 	return u._inner.c
 }
@@ -233,6 +288,21 @@ func (u *MyUnionUnion) Get_c() (*float64, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_c() (*float64, error) {
+	if u._variant != _myUnionVariant_c {
+		var zero *float64
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "c", Got: u._variant.String()}
+	}
+	return u._inner.c, nil
+}
+
+func (u *MyUnionUnion) Ptr_c() **float64 {
+	if u._variant != _myUnionVariant_c {
+		return nil
+	}
+	return &u._inner.c
+}
+
 func NewMyUnionUnion_c(val *float64) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{c: val},
@@ -240,13 +310,20 @@ func NewMyUnionUnion_c(val *float64) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_c(val *float64) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{c: val},
+		_variant: _myUnionVariant_c,
+	}
+}
+
 func (u *MyUnionUnion) Is_d() bool {
 	return u._variant == _myUnionVariant_d
 }
 
 func (u *MyUnionUnion) Unwrap_d() []*int {
 	if u._variant != _myUnionVariant_d {
-		panic("called Unwrap_d on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "d", Got: u._variant.String()})
 	}
 	return u._inner.d
 }
@@ -259,6 +336,21 @@ func (u *MyUnionUnion) Get_d() ([]*int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_d() ([]*int, error) {
+	if u._variant != _myUnionVariant_d {
+		var zero []*int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "d", Got: u._variant.String()}
+	}
+	return u._inner.d, nil
+}
+
+func (u *MyUnionUnion) Ptr_d() *[]*int {
+	if u._variant != _myUnionVariant_d {
+		return nil
+	}
+	return &u._inner.d
+}
+
 func NewMyUnionUnion_d(val []*int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{d: val},
@@ -266,13 +358,20 @@ func NewMyUnionUnion_d(val []*int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_d(val []*int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{d: val},
+		_variant: _myUnionVariant_d,
+	}
+}
+
 func (u *MyUnionUnion) Is_e() bool {
 	return u._variant == _myUnionVariant_e
 }
 
 func (u *MyUnionUnion) Unwrap_e() [5]byte {
 	if u._variant != _myUnionVariant_e {
-		panic("called Unwrap_e on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "e", Got: u._variant.String()})
 	}
 	return u._inner.e
 }
@@ -285,6 +384,21 @@ func (u *MyUnionUnion) Get_e() ([5]byte, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_e() ([5]byte, error) {
+	if u._variant != _myUnionVariant_e {
+		var zero [5]byte
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "e", Got: u._variant.String()}
+	}
+	return u._inner.e, nil
+}
+
+func (u *MyUnionUnion) Ptr_e() *[5]byte {
+	if u._variant != _myUnionVariant_e {
+		return nil
+	}
+	return &u._inner.e
+}
+
 func NewMyUnionUnion_e(val [5]byte) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{e: val},
@@ -292,13 +406,20 @@ func NewMyUnionUnion_e(val [5]byte) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_e(val [5]byte) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{e: val},
+		_variant: _myUnionVariant_e,
+	}
+}
+
 func (u *MyUnionUnion) Is_f() bool {
 	return u._variant == _myUnionVariant_f
 }
 
 func (u *MyUnionUnion) Unwrap_f() map[string][]int {
 	if u._variant != _myUnionVariant_f {
-		panic("called Unwrap_f on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "f", Got: u._variant.String()})
 	}
 	return u._inner.f
 }
@@ -311,6 +432,21 @@ func (u *MyUnionUnion) Get_f() (map[string][]int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_f() (map[string][]int, error) {
+	if u._variant != _myUnionVariant_f {
+		var zero map[string][]int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "f", Got: u._variant.String()}
+	}
+	return u._inner.f, nil
+}
+
+func (u *MyUnionUnion) Ptr_f() *map[string][]int {
+	if u._variant != _myUnionVariant_f {
+		return nil
+	}
+	return &u._inner.f
+}
+
 func NewMyUnionUnion_f(val map[string][]int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{f: val},
@@ -318,13 +454,20 @@ func NewMyUnionUnion_f(val map[string][]int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_f(val map[string][]int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{f: val},
+		_variant: _myUnionVariant_f,
+	}
+}
+
 func (u *MyUnionUnion) Is_g() bool {
 	return u._variant == _myUnionVariant_g
 }
 
 func (u *MyUnionUnion) Unwrap_g() map[int]map[string]bool {
 	if u._variant != _myUnionVariant_g {
-		panic("called Unwrap_g on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "g", Got: u._variant.String()})
 	}
 	return u._inner.g
 }
@@ -337,6 +480,21 @@ func (u *MyUnionUnion) Get_g() (map[int]map[string]bool, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_g() (map[int]map[string]bool, error) {
+	if u._variant != _myUnionVariant_g {
+		var zero map[int]map[string]bool
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "g", Got: u._variant.String()}
+	}
+	return u._inner.g, nil
+}
+
+func (u *MyUnionUnion) Ptr_g() *map[int]map[string]bool {
+	if u._variant != _myUnionVariant_g {
+		return nil
+	}
+	return &u._inner.g
+}
+
 func NewMyUnionUnion_g(val map[int]map[string]bool) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{g: val},
@@ -344,13 +502,20 @@ func NewMyUnionUnion_g(val map[int]map[string]bool) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_g(val map[int]map[string]bool) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{g: val},
+		_variant: _myUnionVariant_g,
+	}
+}
+
 func (u *MyUnionUnion) Is_h() bool {
 	return u._variant == _myUnionVariant_h
 }
 
 func (u *MyUnionUnion) Unwrap_h() chan int {
 	if u._variant != _myUnionVariant_h {
-		panic("called Unwrap_h on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "h", Got: u._variant.String()})
 	}
 	return u._inner.h
 }
@@ -363,6 +528,21 @@ func (u *MyUnionUnion) Get_h() (chan int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_h() (chan int, error) {
+	if u._variant != _myUnionVariant_h {
+		var zero chan int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "h", Got: u._variant.String()}
+	}
+	return u._inner.h, nil
+}
+
+func (u *MyUnionUnion) Ptr_h() *chan int {
+	if u._variant != _myUnionVariant_h {
+		return nil
+	}
+	return &u._inner.h
+}
+
 func NewMyUnionUnion_h(val chan int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{h: val},
@@ -370,13 +550,20 @@ func NewMyUnionUnion_h(val chan int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_h(val chan int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{h: val},
+		_variant: _myUnionVariant_h,
+	}
+}
+
 func (u *MyUnionUnion) Is_i() bool {
 	return u._variant == _myUnionVariant_i
 }
 
 func (u *MyUnionUnion) Unwrap_i() <-chan string {
 	if u._variant != _myUnionVariant_i {
-		panic("called Unwrap_i on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "i", Got: u._variant.String()})
 	}
 	return u._inner.i
 }
@@ -389,6 +576,21 @@ func (u *MyUnionUnion) Get_i() (<-chan string, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_i() (<-chan string, error) {
+	if u._variant != _myUnionVariant_i {
+		var zero <-chan string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "i", Got: u._variant.String()}
+	}
+	return u._inner.i, nil
+}
+
+func (u *MyUnionUnion) Ptr_i() *<-chan string {
+	if u._variant != _myUnionVariant_i {
+		return nil
+	}
+	return &u._inner.i
+}
+
 func NewMyUnionUnion_i(val <-chan string) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{i: val},
@@ -396,13 +598,20 @@ func NewMyUnionUnion_i(val <-chan string) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_i(val <-chan string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{i: val},
+		_variant: _myUnionVariant_i,
+	}
+}
+
 func (u *MyUnionUnion) Is_j() bool {
 	return u._variant == _myUnionVariant_j
 }
 
 func (u *MyUnionUnion) Unwrap_j() chan<- bool {
 	if u._variant != _myUnionVariant_j {
-		panic("called Unwrap_j on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "j", Got: u._variant.String()})
 	}
 	return u._inner.j
 }
@@ -415,6 +624,21 @@ func (u *MyUnionUnion) Get_j() (chan<- bool, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_j() (chan<- bool, error) {
+	if u._variant != _myUnionVariant_j {
+		var zero chan<- bool
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "j", Got: u._variant.String()}
+	}
+	return u._inner.j, nil
+}
+
+func (u *MyUnionUnion) Ptr_j() *chan<- bool {
+	if u._variant != _myUnionVariant_j {
+		return nil
+	}
+	return &u._inner.j
+}
+
 func NewMyUnionUnion_j(val chan<- bool) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{j: val},
@@ -422,13 +646,20 @@ func NewMyUnionUnion_j(val chan<- bool) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_j(val chan<- bool) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{j: val},
+		_variant: _myUnionVariant_j,
+	}
+}
+
 func (u *MyUnionUnion) Is_k() bool {
 	return u._variant == _myUnionVariant_k
 }
 
 func (u *MyUnionUnion) Unwrap_k() func() {
 	if u._variant != _myUnionVariant_k {
-		panic("called Unwrap_k on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "k", Got: u._variant.String()})
 	}
 	return u._inner.k
 }
@@ -441,6 +672,21 @@ func (u *MyUnionUnion) Get_k() (func(), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_k() (func(), error) {
+	if u._variant != _myUnionVariant_k {
+		var zero func()
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "k", Got: u._variant.String()}
+	}
+	return u._inner.k, nil
+}
+
+func (u *MyUnionUnion) Ptr_k() *func() {
+	if u._variant != _myUnionVariant_k {
+		return nil
+	}
+	return &u._inner.k
+}
+
 func NewMyUnionUnion_k(val func()) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{k: val},
@@ -448,13 +694,20 @@ func NewMyUnionUnion_k(val func()) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_k(val func()) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{k: val},
+		_variant: _myUnionVariant_k,
+	}
+}
+
 func (u *MyUnionUnion) Is_l() bool {
 	return u._variant == _myUnionVariant_l
 }
 
 func (u *MyUnionUnion) Unwrap_l() func(a int, b string) (int, error) {
 	if u._variant != _myUnionVariant_l {
-		panic("called Unwrap_l on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "l", Got: u._variant.String()})
 	}
 	return u._inner.l
 }
@@ -467,6 +720,21 @@ func (u *MyUnionUnion) Get_l() (func(a int, b string) (int, error), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_l() (func(a int, b string) (int, error), error) {
+	if u._variant != _myUnionVariant_l {
+		var zero func(a int, b string) (int, error)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "l", Got: u._variant.String()}
+	}
+	return u._inner.l, nil
+}
+
+func (u *MyUnionUnion) Ptr_l() *func(a int, b string) (int, error) {
+	if u._variant != _myUnionVariant_l {
+		return nil
+	}
+	return &u._inner.l
+}
+
 func NewMyUnionUnion_l(val func(a int, b string) (int, error)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{l: val},
@@ -474,13 +742,20 @@ func NewMyUnionUnion_l(val func(a int, b string) (int, error)) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_l(val func(a int, b string) (int, error)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{l: val},
+		_variant: _myUnionVariant_l,
+	}
+}
+
 func (u *MyUnionUnion) Is_m() bool {
 	return u._variant == _myUnionVariant_m
 }
 
 func (u *MyUnionUnion) Unwrap_m() func(format string, args ...any) string {
 	if u._variant != _myUnionVariant_m {
-		panic("called Unwrap_m on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "m", Got: u._variant.String()})
 	}
 	return u._inner.m
 }
@@ -493,6 +768,21 @@ func (u *MyUnionUnion) Get_m() (func(format string, args ...any) string, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_m() (func(format string, args ...any) string, error) {
+	if u._variant != _myUnionVariant_m {
+		var zero func(format string, args ...any) string
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "m", Got: u._variant.String()}
+	}
+	return u._inner.m, nil
+}
+
+func (u *MyUnionUnion) Ptr_m() *func(format string, args ...any) string {
+	if u._variant != _myUnionVariant_m {
+		return nil
+	}
+	return &u._inner.m
+}
+
 func NewMyUnionUnion_m(val func(format string, args ...any) string) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{m: val},
@@ -500,13 +790,20 @@ func NewMyUnionUnion_m(val func(format string, args ...any) string) MyUnionUnion
 	}
 }
 
+func (u *MyUnionUnion) Set_m(val func(format string, args ...any) string) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{m: val},
+		_variant: _myUnionVariant_m,
+	}
+}
+
 func (u *MyUnionUnion) Is_n() bool {
 	return u._variant == _myUnionVariant_n
 }
 
 func (u *MyUnionUnion) Unwrap_n() func(x int, y int) (sum int, diff int) {
 	if u._variant != _myUnionVariant_n {
-		panic("called Unwrap_n on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "n", Got: u._variant.String()})
 	}
 	return u._inner.n
 }
@@ -519,6 +816,21 @@ func (u *MyUnionUnion) Get_n() (func(x int, y int) (sum int, diff int), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_n() (func(x int, y int) (sum int, diff int), error) {
+	if u._variant != _myUnionVariant_n {
+		var zero func(x int, y int) (sum int, diff int)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "n", Got: u._variant.String()}
+	}
+	return u._inner.n, nil
+}
+
+func (u *MyUnionUnion) Ptr_n() *func(x int, y int) (sum int, diff int) {
+	if u._variant != _myUnionVariant_n {
+		return nil
+	}
+	return &u._inner.n
+}
+
 func NewMyUnionUnion_n(val func(x int, y int) (sum int, diff int)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{n: val},
@@ -526,13 +838,20 @@ func NewMyUnionUnion_n(val func(x int, y int) (sum int, diff int)) MyUnionUnion
 	}
 }
 
+func (u *MyUnionUnion) Set_n(val func(x int, y int) (sum int, diff int)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{n: val},
+		_variant: _myUnionVariant_n,
+	}
+}
+
 func (u *MyUnionUnion) Is_o() bool {
 	return u._variant == _myUnionVariant_o
 }
 
 func (u *MyUnionUnion) Unwrap_o() func(multiplier int) func(int) int {
 	if u._variant != _myUnionVariant_o {
-		panic("called Unwrap_o on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "o", Got: u._variant.String()})
 	}
 	return u._inner.o
 }
@@ -545,6 +864,21 @@ func (u *MyUnionUnion) Get_o() (func(multiplier int) func(int) int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_o() (func(multiplier int) func(int) int, error) {
+	if u._variant != _myUnionVariant_o {
+		var zero func(multiplier int) func(int) int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "o", Got: u._variant.String()}
+	}
+	return u._inner.o, nil
+}
+
+func (u *MyUnionUnion) Ptr_o() *func(multiplier int) func(int) int {
+	if u._variant != _myUnionVariant_o {
+		return nil
+	}
+	return &u._inner.o
+}
+
 func NewMyUnionUnion_o(val func(multiplier int) func(int) int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{o: val},
@@ -552,13 +886,20 @@ func NewMyUnionUnion_o(val func(multiplier int) func(int) int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_o(val func(multiplier int) func(int) int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{o: val},
+		_variant: _myUnionVariant_o,
+	}
+}
+
 func (u *MyUnionUnion) Is_p() bool {
 	return u._variant == _myUnionVariant_p
 }
 
 func (u *MyUnionUnion) Unwrap_p() func(callback func(int) bool) error {
 	if u._variant != _myUnionVariant_p {
-		panic("called Unwrap_p on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "p", Got: u._variant.String()})
 	}
 	return u._inner.p
 }
@@ -571,6 +912,21 @@ func (u *MyUnionUnion) Get_p() (func(callback func(int) bool) error, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_p() (func(callback func(int) bool) error, error) {
+	if u._variant != _myUnionVariant_p {
+		var zero func(callback func(int) bool) error
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "p", Got: u._variant.String()}
+	}
+	return u._inner.p, nil
+}
+
+func (u *MyUnionUnion) Ptr_p() *func(callback func(int) bool) error {
+	if u._variant != _myUnionVariant_p {
+		return nil
+	}
+	return &u._inner.p
+}
+
 func NewMyUnionUnion_p(val func(callback func(int) bool) error) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{p: val},
@@ -578,13 +934,20 @@ func NewMyUnionUnion_p(val func(callback func(int) bool) error) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_p(val func(callback func(int) bool) error) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{p: val},
+		_variant: _myUnionVariant_p,
+	}
+}
+
 func (u *MyUnionUnion) Is_q() bool {
 	return u._variant == _myUnionVariant_q
 }
 
 func (u *MyUnionUnion) Unwrap_q() func(in <-chan int, out chan<- int) {
 	if u._variant != _myUnionVariant_q {
-		panic("called Unwrap_q on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "q", Got: u._variant.String()})
 	}
 	return u._inner.q
 }
@@ -597,6 +960,21 @@ func (u *MyUnionUnion) Get_q() (func(in <-chan int, out chan<- int), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_q() (func(in <-chan int, out chan<- int), error) {
+	if u._variant != _myUnionVariant_q {
+		var zero func(in <-chan int, out chan<- int)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "q", Got: u._variant.String()}
+	}
+	return u._inner.q, nil
+}
+
+func (u *MyUnionUnion) Ptr_q() *func(in <-chan int, out chan<- int) {
+	if u._variant != _myUnionVariant_q {
+		return nil
+	}
+	return &u._inner.q
+}
+
 func NewMyUnionUnion_q(val func(in <-chan int, out chan<- int)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{q: val},
@@ -604,13 +982,20 @@ func NewMyUnionUnion_q(val func(in <-chan int, out chan<- int)) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_q(val func(in <-chan int, out chan<- int)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{q: val},
+		_variant: _myUnionVariant_q,
+	}
+}
+
 func (u *MyUnionUnion) Is_r() bool {
 	return u._variant == _myUnionVariant_r
 }
 
 func (u *MyUnionUnion) Unwrap_r() func(ctx context.Context) error {
 	if u._variant != _myUnionVariant_r {
-		panic("called Unwrap_r on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "r", Got: u._variant.String()})
 	}
 	return u._inner.r
 }
@@ -623,6 +1008,21 @@ func (u *MyUnionUnion) Get_r() (func(ctx context.Context) error, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_r() (func(ctx context.Context) error, error) {
+	if u._variant != _myUnionVariant_r {
+		var zero func(ctx context.Context) error
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "r", Got: u._variant.String()}
+	}
+	return u._inner.r, nil
+}
+
+func (u *MyUnionUnion) Ptr_r() *func(ctx context.Context) error {
+	if u._variant != _myUnionVariant_r {
+		return nil
+	}
+	return &u._inner.r
+}
+
 func NewMyUnionUnion_r(val func(ctx context.Context) error) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{r: val},
@@ -630,13 +1030,20 @@ func NewMyUnionUnion_r(val func(ctx context.Context) error) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_r(val func(ctx context.Context) error) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{r: val},
+		_variant: _myUnionVariant_r,
+	}
+}
+
 func (u *MyUnionUnion) Is_s() bool {
 	return u._variant == _myUnionVariant_s
 }
 
 func (u *MyUnionUnion) Unwrap_s() func(w io.Writer, r io.Reader) (int64, error) {
 	if u._variant != _myUnionVariant_s {
-		panic("called Unwrap_s on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "s", Got: u._variant.String()})
 	}
 	return u._inner.s
 }
@@ -649,6 +1056,21 @@ func (u *MyUnionUnion) Get_s() (func(w io.Writer, r io.Reader) (int64, error), b
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_s() (func(w io.Writer, r io.Reader) (int64, error), error) {
+	if u._variant != _myUnionVariant_s {
+		var zero func(w io.Writer, r io.Reader) (int64, error)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "s", Got: u._variant.String()}
+	}
+	return u._inner.s, nil
+}
+
+func (u *MyUnionUnion) Ptr_s() *func(w io.Writer, r io.Reader) (int64, error) {
+	if u._variant != _myUnionVariant_s {
+		return nil
+	}
+	return &u._inner.s
+}
+
 func NewMyUnionUnion_s(val func(w io.Writer, r io.Reader) (int64, error)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{s: val},
@@ -656,13 +1078,20 @@ func NewMyUnionUnion_s(val func(w io.Writer, r io.Reader) (int64, error)) MyUnio
 	}
 }
 
+func (u *MyUnionUnion) Set_s(val func(w io.Writer, r io.Reader) (int64, error)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{s: val},
+		_variant: _myUnionVariant_s,
+	}
+}
+
 func (u *MyUnionUnion) Is_t() bool {
 	return u._variant == _myUnionVariant_t
 }
 
 func (u *MyUnionUnion) Unwrap_t() *func(int) int {
 	if u._variant != _myUnionVariant_t {
-		panic("called Unwrap_t on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "t", Got: u._variant.String()})
 	}
 	return u._inner.t
 }
@@ -675,20 +1104,42 @@ func (u *MyUnionUnion) Get_t() (*func(int) int, bool) {
 	return zero, false
 }
 
-func NewMyUnionUnion_t(val *func(int) int) MyUnionUnion {
-	return MyUnionUnion{
-		_inner:   myUnion{t: val},
-		_variant: _myUnionVariant_t,
+func (u *MyUnionUnion) Try_t() (*func(int) int, error) {
+	if u._variant != _myUnionVariant_t {
+		var zero *func(int) int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "t", Got: u._variant.String()}
 	}
+	return u._inner.t, nil
 }
 
-func (u *MyUnionUnion) Is_u() bool {
-	return u._variant == _myUnionVariant_u
+func (u *MyUnionUnion) Ptr_t() **func(int) int {
+	if u._variant != _myUnionVariant_t {
+		return nil
+	}
+	return &u._inner.t
+}
+
+func NewMyUnionUnion_t(val *func(int) int) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{t: val},
+		_variant: _myUnionVariant_t,
+	}
+}
+
+func (u *MyUnionUnion) Set_t(val *func(int) int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{t: val},
+		_variant: _myUnionVariant_t,
+	}
+}
+
+func (u *MyUnionUnion) Is_u() bool {
+	return u._variant == _myUnionVariant_u
 }
 
 func (u *MyUnionUnion) Unwrap_u() []func() error {
 	if u._variant != _myUnionVariant_u {
-		panic("called Unwrap_u on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "u", Got: u._variant.String()})
 	}
 	return u._inner.u
 }
@@ -701,6 +1152,21 @@ func (u *MyUnionUnion) Get_u() ([]func() error, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_u() ([]func() error, error) {
+	if u._variant != _myUnionVariant_u {
+		var zero []func() error
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "u", Got: u._variant.String()}
+	}
+	return u._inner.u, nil
+}
+
+func (u *MyUnionUnion) Ptr_u() *[]func() error {
+	if u._variant != _myUnionVariant_u {
+		return nil
+	}
+	return &u._inner.u
+}
+
 func NewMyUnionUnion_u(val []func() error) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{u: val},
@@ -708,13 +1174,20 @@ func NewMyUnionUnion_u(val []func() error) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_u(val []func() error) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{u: val},
+		_variant: _myUnionVariant_u,
+	}
+}
+
 func (u *MyUnionUnion) Is_v() bool {
 	return u._variant == _myUnionVariant_v
 }
 
 func (u *MyUnionUnion) Unwrap_v() map[string]func(int) int {
 	if u._variant != _myUnionVariant_v {
-		panic("called Unwrap_v on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "v", Got: u._variant.String()})
 	}
 	return u._inner.v
 }
@@ -727,6 +1200,21 @@ func (u *MyUnionUnion) Get_v() (map[string]func(int) int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_v() (map[string]func(int) int, error) {
+	if u._variant != _myUnionVariant_v {
+		var zero map[string]func(int) int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "v", Got: u._variant.String()}
+	}
+	return u._inner.v, nil
+}
+
+func (u *MyUnionUnion) Ptr_v() *map[string]func(int) int {
+	if u._variant != _myUnionVariant_v {
+		return nil
+	}
+	return &u._inner.v
+}
+
 func NewMyUnionUnion_v(val map[string]func(int) int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{v: val},
@@ -734,13 +1222,20 @@ func NewMyUnionUnion_v(val map[string]func(int) int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_v(val map[string]func(int) int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{v: val},
+		_variant: _myUnionVariant_v,
+	}
+}
+
 func (u *MyUnionUnion) Is_w() bool {
 	return u._variant == _myUnionVariant_w
 }
 
 func (u *MyUnionUnion) Unwrap_w() ***int {
 	if u._variant != _myUnionVariant_w {
-		panic("called Unwrap_w on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "w", Got: u._variant.String()})
 	}
 	return u._inner.w
 }
@@ -753,6 +1248,21 @@ func (u *MyUnionUnion) Get_w() (***int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_w() (***int, error) {
+	if u._variant != _myUnionVariant_w {
+		var zero ***int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "w", Got: u._variant.String()}
+	}
+	return u._inner.w, nil
+}
+
+func (u *MyUnionUnion) Ptr_w() ****int {
+	if u._variant != _myUnionVariant_w {
+		return nil
+	}
+	return &u._inner.w
+}
+
 func NewMyUnionUnion_w(val ***int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{w: val},
@@ -760,13 +1270,20 @@ func NewMyUnionUnion_w(val ***int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_w(val ***int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{w: val},
+		_variant: _myUnionVariant_w,
+	}
+}
+
 func (u *MyUnionUnion) Is_x() bool {
 	return u._variant == _myUnionVariant_x
 }
 
 func (u *MyUnionUnion) Unwrap_x() *[]*[3]int {
 	if u._variant != _myUnionVariant_x {
-		panic("called Unwrap_x on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "x", Got: u._variant.String()})
 	}
 	return u._inner.x
 }
@@ -779,6 +1296,21 @@ func (u *MyUnionUnion) Get_x() (*[]*[3]int, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_x() (*[]*[3]int, error) {
+	if u._variant != _myUnionVariant_x {
+		var zero *[]*[3]int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "x", Got: u._variant.String()}
+	}
+	return u._inner.x, nil
+}
+
+func (u *MyUnionUnion) Ptr_x() **[]*[3]int {
+	if u._variant != _myUnionVariant_x {
+		return nil
+	}
+	return &u._inner.x
+}
+
 func NewMyUnionUnion_x(val *[]*[3]int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{x: val},
@@ -786,13 +1318,20 @@ func NewMyUnionUnion_x(val *[]*[3]int) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_x(val *[]*[3]int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{x: val},
+		_variant: _myUnionVariant_x,
+	}
+}
+
 func (u *MyUnionUnion) Is_y() bool {
 	return u._variant == _myUnionVariant_y
 }
 
 func (u *MyUnionUnion) Unwrap_y() func(*int, **string) *bool {
 	if u._variant != _myUnionVariant_y {
-		panic("called Unwrap_y on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "y", Got: u._variant.String()})
 	}
 	return u._inner.y
 }
@@ -805,6 +1344,21 @@ func (u *MyUnionUnion) Get_y() (func(*int, **string) *bool, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_y() (func(*int, **string) *bool, error) {
+	if u._variant != _myUnionVariant_y {
+		var zero func(*int, **string) *bool
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "y", Got: u._variant.String()}
+	}
+	return u._inner.y, nil
+}
+
+func (u *MyUnionUnion) Ptr_y() *func(*int, **string) *bool {
+	if u._variant != _myUnionVariant_y {
+		return nil
+	}
+	return &u._inner.y
+}
+
 func NewMyUnionUnion_y(val func(*int, **string) *bool) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{y: val},
@@ -812,13 +1366,20 @@ func NewMyUnionUnion_y(val func(*int, **string) *bool) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_y(val func(*int, **string) *bool) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{y: val},
+		_variant: _myUnionVariant_y,
+	}
+}
+
 func (u *MyUnionUnion) Is_z() bool {
 	return u._variant == _myUnionVariant_z
 }
 
 func (u *MyUnionUnion) Unwrap_z() any {
 	if u._variant != _myUnionVariant_z {
-		panic("called Unwrap_z on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "z", Got: u._variant.String()})
 	}
 	return u._inner.z
 }
@@ -831,6 +1392,21 @@ func (u *MyUnionUnion) Get_z() (any, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_z() (any, error) {
+	if u._variant != _myUnionVariant_z {
+		var zero any
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "z", Got: u._variant.String()}
+	}
+	return u._inner.z, nil
+}
+
+func (u *MyUnionUnion) Ptr_z() *any {
+	if u._variant != _myUnionVariant_z {
+		return nil
+	}
+	return &u._inner.z
+}
+
 func NewMyUnionUnion_z(val any) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{z: val},
@@ -838,13 +1414,20 @@ func NewMyUnionUnion_z(val any) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_z(val any) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{z: val},
+		_variant: _myUnionVariant_z,
+	}
+}
+
 func (u *MyUnionUnion) Is_aa() bool {
 	return u._variant == _myUnionVariant_aa
 }
 
 func (u *MyUnionUnion) Unwrap_aa() []any {
 	if u._variant != _myUnionVariant_aa {
-		panic("called Unwrap_aa on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "aa", Got: u._variant.String()})
 	}
 	return u._inner.aa
 }
@@ -857,6 +1440,21 @@ func (u *MyUnionUnion) Get_aa() ([]any, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_aa() ([]any, error) {
+	if u._variant != _myUnionVariant_aa {
+		var zero []any
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "aa", Got: u._variant.String()}
+	}
+	return u._inner.aa, nil
+}
+
+func (u *MyUnionUnion) Ptr_aa() *[]any {
+	if u._variant != _myUnionVariant_aa {
+		return nil
+	}
+	return &u._inner.aa
+}
+
 func NewMyUnionUnion_aa(val []any) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{aa: val},
@@ -864,13 +1462,20 @@ func NewMyUnionUnion_aa(val []any) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_aa(val []any) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{aa: val},
+		_variant: _myUnionVariant_aa,
+	}
+}
+
 func (u *MyUnionUnion) Is_bb() bool {
 	return u._variant == _myUnionVariant_bb
 }
 
 func (u *MyUnionUnion) Unwrap_bb() map[any]any {
 	if u._variant != _myUnionVariant_bb {
-		panic("called Unwrap_bb on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "bb", Got: u._variant.String()})
 	}
 	return u._inner.bb
 }
@@ -883,6 +1488,21 @@ func (u *MyUnionUnion) Get_bb() (map[any]any, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_bb() (map[any]any, error) {
+	if u._variant != _myUnionVariant_bb {
+		var zero map[any]any
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "bb", Got: u._variant.String()}
+	}
+	return u._inner.bb, nil
+}
+
+func (u *MyUnionUnion) Ptr_bb() *map[any]any {
+	if u._variant != _myUnionVariant_bb {
+		return nil
+	}
+	return &u._inner.bb
+}
+
 func NewMyUnionUnion_bb(val map[any]any) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{bb: val},
@@ -890,13 +1510,20 @@ func NewMyUnionUnion_bb(val map[any]any) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_bb(val map[any]any) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{bb: val},
+		_variant: _myUnionVariant_bb,
+	}
+}
+
 func (u *MyUnionUnion) Is_cc() bool {
 	return u._variant == _myUnionVariant_cc
 }
 
 func (u *MyUnionUnion) Unwrap_cc() func() (int, int, error, error) {
 	if u._variant != _myUnionVariant_cc {
-		panic("called Unwrap_cc on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "cc", Got: u._variant.String()})
 	}
 	return u._inner.cc
 }
@@ -909,6 +1536,21 @@ func (u *MyUnionUnion) Get_cc() (func() (int, int, error, error), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_cc() (func() (int, int, error, error), error) {
+	if u._variant != _myUnionVariant_cc {
+		var zero func() (int, int, error, error)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "cc", Got: u._variant.String()}
+	}
+	return u._inner.cc, nil
+}
+
+func (u *MyUnionUnion) Ptr_cc() *func() (int, int, error, error) {
+	if u._variant != _myUnionVariant_cc {
+		return nil
+	}
+	return &u._inner.cc
+}
+
 func NewMyUnionUnion_cc(val func() (int, int, error, error)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{cc: val},
@@ -916,13 +1558,20 @@ func NewMyUnionUnion_cc(val func() (int, int, error, error)) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_cc(val func() (int, int, error, error)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{cc: val},
+		_variant: _myUnionVariant_cc,
+	}
+}
+
 func (u *MyUnionUnion) Is_dd() bool {
 	return u._variant == _myUnionVariant_dd
 }
 
 func (u *MyUnionUnion) Unwrap_dd() func(...string) {
 	if u._variant != _myUnionVariant_dd {
-		panic("called Unwrap_dd on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "dd", Got: u._variant.String()})
 	}
 	return u._inner.dd
 }
@@ -935,6 +1584,21 @@ func (u *MyUnionUnion) Get_dd() (func(...string), bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_dd() (func(...string), error) {
+	if u._variant != _myUnionVariant_dd {
+		var zero func(...string)
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "dd", Got: u._variant.String()}
+	}
+	return u._inner.dd, nil
+}
+
+func (u *MyUnionUnion) Ptr_dd() *func(...string) {
+	if u._variant != _myUnionVariant_dd {
+		return nil
+	}
+	return &u._inner.dd
+}
+
 func NewMyUnionUnion_dd(val func(...string)) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{dd: val},
@@ -942,13 +1606,20 @@ func NewMyUnionUnion_dd(val func(...string)) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_dd(val func(...string)) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{dd: val},
+		_variant: _myUnionVariant_dd,
+	}
+}
+
 func (u *MyUnionUnion) Is_ee() bool {
 	return u._variant == _myUnionVariant_ee
 }
 
 func (u *MyUnionUnion) Unwrap_ee() func(func(func(int) int) func(int) int) func(func(int) int) func(int) int {
 	if u._variant != _myUnionVariant_ee {
-		panic("called Unwrap_ee on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ee", Got: u._variant.String()})
 	}
 	return u._inner.ee
 }
@@ -961,6 +1632,21 @@ func (u *MyUnionUnion) Get_ee() (func(func(func(int) int) func(int) int) func(fu
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_ee() (func(func(func(int) int) func(int) int) func(func(int) int) func(int) int, error) {
+	if u._variant != _myUnionVariant_ee {
+		var zero func(func(func(int) int) func(int) int) func(func(int) int) func(int) int
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ee", Got: u._variant.String()}
+	}
+	return u._inner.ee, nil
+}
+
+func (u *MyUnionUnion) Ptr_ee() *func(func(func(int) int) func(int) int) func(func(int) int) func(int) int {
+	if u._variant != _myUnionVariant_ee {
+		return nil
+	}
+	return &u._inner.ee
+}
+
 func NewMyUnionUnion_ee(val func(func(func(int) int) func(int) int) func(func(int) int) func(int) int) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{ee: val},
@@ -968,13 +1654,20 @@ func NewMyUnionUnion_ee(val func(func(func(int) int) func(int) int) func(func(in
 	}
 }
 
+func (u *MyUnionUnion) Set_ee(val func(func(func(int) int) func(int) int) func(func(int) int) func(int) int) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{ee: val},
+		_variant: _myUnionVariant_ee,
+	}
+}
+
 func (u *MyUnionUnion) Is_ff() bool {
 	return u._variant == _myUnionVariant_ff
 }
 
 func (u *MyUnionUnion) Unwrap_ff() rune {
 	if u._variant != _myUnionVariant_ff {
-		panic("called Unwrap_ff on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ff", Got: u._variant.String()})
 	}
 	return u._inner.ff
 }
@@ -987,6 +1680,21 @@ func (u *MyUnionUnion) Get_ff() (rune, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_ff() (rune, error) {
+	if u._variant != _myUnionVariant_ff {
+		var zero rune
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ff", Got: u._variant.String()}
+	}
+	return u._inner.ff, nil
+}
+
+func (u *MyUnionUnion) Ptr_ff() *rune {
+	if u._variant != _myUnionVariant_ff {
+		return nil
+	}
+	return &u._inner.ff
+}
+
 func NewMyUnionUnion_ff(val rune) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{ff: val},
@@ -994,13 +1702,20 @@ func NewMyUnionUnion_ff(val rune) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_ff(val rune) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{ff: val},
+		_variant: _myUnionVariant_ff,
+	}
+}
+
 func (u *MyUnionUnion) Is_gg() bool {
 	return u._variant == _myUnionVariant_gg
 }
 
 func (u *MyUnionUnion) Unwrap_gg() int32 {
 	if u._variant != _myUnionVariant_gg {
-		panic("called Unwrap_gg on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "gg", Got: u._variant.String()})
 	}
 	return u._inner.gg
 }
@@ -1013,6 +1728,21 @@ func (u *MyUnionUnion) Get_gg() (int32, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_gg() (int32, error) {
+	if u._variant != _myUnionVariant_gg {
+		var zero int32
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "gg", Got: u._variant.String()}
+	}
+	return u._inner.gg, nil
+}
+
+func (u *MyUnionUnion) Ptr_gg() *int32 {
+	if u._variant != _myUnionVariant_gg {
+		return nil
+	}
+	return &u._inner.gg
+}
+
 func NewMyUnionUnion_gg(val int32) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{gg: val},
@@ -1020,13 +1750,20 @@ func NewMyUnionUnion_gg(val int32) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_gg(val int32) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{gg: val},
+		_variant: _myUnionVariant_gg,
+	}
+}
+
 func (u *MyUnionUnion) Is_hh() bool {
 	return u._variant == _myUnionVariant_hh
 }
 
 func (u *MyUnionUnion) Unwrap_hh() byte {
 	if u._variant != _myUnionVariant_hh {
-		panic("called Unwrap_hh on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "hh", Got: u._variant.String()})
 	}
 	return u._inner.hh
 }
@@ -1039,6 +1776,21 @@ func (u *MyUnionUnion) Get_hh() (byte, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_hh() (byte, error) {
+	if u._variant != _myUnionVariant_hh {
+		var zero byte
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "hh", Got: u._variant.String()}
+	}
+	return u._inner.hh, nil
+}
+
+func (u *MyUnionUnion) Ptr_hh() *byte {
+	if u._variant != _myUnionVariant_hh {
+		return nil
+	}
+	return &u._inner.hh
+}
+
 func NewMyUnionUnion_hh(val byte) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{hh: val},
@@ -1046,13 +1798,20 @@ func NewMyUnionUnion_hh(val byte) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_hh(val byte) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{hh: val},
+		_variant: _myUnionVariant_hh,
+	}
+}
+
 func (u *MyUnionUnion) Is_ii() bool {
 	return u._variant == _myUnionVariant_ii
 }
 
 func (u *MyUnionUnion) Unwrap_ii() uint8 {
 	if u._variant != _myUnionVariant_ii {
-		panic("called Unwrap_ii on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ii", Got: u._variant.String()})
 	}
 	return u._inner.ii
 }
@@ -1065,6 +1824,21 @@ func (u *MyUnionUnion) Get_ii() (uint8, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_ii() (uint8, error) {
+	if u._variant != _myUnionVariant_ii {
+		var zero uint8
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ii", Got: u._variant.String()}
+	}
+	return u._inner.ii, nil
+}
+
+func (u *MyUnionUnion) Ptr_ii() *uint8 {
+	if u._variant != _myUnionVariant_ii {
+		return nil
+	}
+	return &u._inner.ii
+}
+
 func NewMyUnionUnion_ii(val uint8) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{ii: val},
@@ -1072,13 +1846,20 @@ func NewMyUnionUnion_ii(val uint8) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_ii(val uint8) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{ii: val},
+		_variant: _myUnionVariant_ii,
+	}
+}
+
 func (u *MyUnionUnion) Is_jj() bool {
 	return u._variant == _myUnionVariant_jj
 }
 
 func (u *MyUnionUnion) Unwrap_jj() Generic[int] {
 	if u._variant != _myUnionVariant_jj {
-		panic("called Unwrap_jj on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "jj", Got: u._variant.String()})
 	}
 	return u._inner.jj
 }
@@ -1091,6 +1872,21 @@ func (u *MyUnionUnion) Get_jj() (Generic[int], bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_jj() (Generic[int], error) {
+	if u._variant != _myUnionVariant_jj {
+		var zero Generic[int]
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "jj", Got: u._variant.String()}
+	}
+	return u._inner.jj, nil
+}
+
+func (u *MyUnionUnion) Ptr_jj() *Generic[int] {
+	if u._variant != _myUnionVariant_jj {
+		return nil
+	}
+	return &u._inner.jj
+}
+
 func NewMyUnionUnion_jj(val Generic[int]) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{jj: val},
@@ -1098,13 +1894,20 @@ func NewMyUnionUnion_jj(val Generic[int]) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_jj(val Generic[int]) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{jj: val},
+		_variant: _myUnionVariant_jj,
+	}
+}
+
 func (u *MyUnionUnion) Is_kk() bool {
 	return u._variant == _myUnionVariant_kk
 }
 
 func (u *MyUnionUnion) Unwrap_kk() Generic[string] {
 	if u._variant != _myUnionVariant_kk {
-		panic("called Unwrap_kk on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "kk", Got: u._variant.String()})
 	}
 	return u._inner.kk
 }
@@ -1117,6 +1920,21 @@ func (u *MyUnionUnion) Get_kk() (Generic[string], bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_kk() (Generic[string], error) {
+	if u._variant != _myUnionVariant_kk {
+		var zero Generic[string]
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "kk", Got: u._variant.String()}
+	}
+	return u._inner.kk, nil
+}
+
+func (u *MyUnionUnion) Ptr_kk() *Generic[string] {
+	if u._variant != _myUnionVariant_kk {
+		return nil
+	}
+	return &u._inner.kk
+}
+
 func NewMyUnionUnion_kk(val Generic[string]) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{kk: val},
@@ -1124,13 +1942,20 @@ func NewMyUnionUnion_kk(val Generic[string]) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_kk(val Generic[string]) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{kk: val},
+		_variant: _myUnionVariant_kk,
+	}
+}
+
 func (u *MyUnionUnion) Is_ll() bool {
 	return u._variant == _myUnionVariant_ll
 }
 
 func (u *MyUnionUnion) Unwrap_ll() Generic[*float64] {
 	if u._variant != _myUnionVariant_ll {
-		panic("called Unwrap_ll on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ll", Got: u._variant.String()})
 	}
 	return u._inner.ll
 }
@@ -1143,6 +1968,21 @@ func (u *MyUnionUnion) Get_ll() (Generic[*float64], bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_ll() (Generic[*float64], error) {
+	if u._variant != _myUnionVariant_ll {
+		var zero Generic[*float64]
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "ll", Got: u._variant.String()}
+	}
+	return u._inner.ll, nil
+}
+
+func (u *MyUnionUnion) Ptr_ll() *Generic[*float64] {
+	if u._variant != _myUnionVariant_ll {
+		return nil
+	}
+	return &u._inner.ll
+}
+
 func NewMyUnionUnion_ll(val Generic[*float64]) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{ll: val},
@@ -1150,13 +1990,20 @@ func NewMyUnionUnion_ll(val Generic[*float64]) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_ll(val Generic[*float64]) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{ll: val},
+		_variant: _myUnionVariant_ll,
+	}
+}
+
 func (u *MyUnionUnion) Is_mm() bool {
 	return u._variant == _myUnionVariant_mm
 }
 
 func (u *MyUnionUnion) Unwrap_mm() TwoParam[int, string] {
 	if u._variant != _myUnionVariant_mm {
-		panic("called Unwrap_mm on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "mm", Got: u._variant.String()})
 	}
 	return u._inner.mm
 }
@@ -1169,6 +2016,21 @@ func (u *MyUnionUnion) Get_mm() (TwoParam[int, string], bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_mm() (TwoParam[int, string], error) {
+	if u._variant != _myUnionVariant_mm {
+		var zero TwoParam[int, string]
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "mm", Got: u._variant.String()}
+	}
+	return u._inner.mm, nil
+}
+
+func (u *MyUnionUnion) Ptr_mm() *TwoParam[int, string] {
+	if u._variant != _myUnionVariant_mm {
+		return nil
+	}
+	return &u._inner.mm
+}
+
 func NewMyUnionUnion_mm(val TwoParam[int, string]) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{mm: val},
@@ -1176,13 +2038,20 @@ func NewMyUnionUnion_mm(val TwoParam[int, string]) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_mm(val TwoParam[int, string]) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{mm: val},
+		_variant: _myUnionVariant_mm,
+	}
+}
+
 func (u *MyUnionUnion) Is_nn() bool {
 	return u._variant == _myUnionVariant_nn
 }
 
 func (u *MyUnionUnion) Unwrap_nn() Generic[Generic[int]] {
 	if u._variant != _myUnionVariant_nn {
-		panic("called Unwrap_nn on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "nn", Got: u._variant.String()})
 	}
 	return u._inner.nn
 }
@@ -1195,6 +2064,21 @@ func (u *MyUnionUnion) Get_nn() (Generic[Generic[int]], bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_nn() (Generic[Generic[int]], error) {
+	if u._variant != _myUnionVariant_nn {
+		var zero Generic[Generic[int]]
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "nn", Got: u._variant.String()}
+	}
+	return u._inner.nn, nil
+}
+
+func (u *MyUnionUnion) Ptr_nn() *Generic[Generic[int]] {
+	if u._variant != _myUnionVariant_nn {
+		return nil
+	}
+	return &u._inner.nn
+}
+
 func NewMyUnionUnion_nn(val Generic[Generic[int]]) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{nn: val},
@@ -1202,13 +2086,20 @@ func NewMyUnionUnion_nn(val Generic[Generic[int]]) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_nn(val Generic[Generic[int]]) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{nn: val},
+		_variant: _myUnionVariant_nn,
+	}
+}
+
 func (u *MyUnionUnion) Is_oo() bool {
 	return u._variant == _myUnionVariant_oo
 }
 
 func (u *MyUnionUnion) Unwrap_oo() time.Time {
 	if u._variant != _myUnionVariant_oo {
-		panic("called Unwrap_oo on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "oo", Got: u._variant.String()})
 	}
 	return u._inner.oo
 }
@@ -1221,6 +2112,21 @@ func (u *MyUnionUnion) Get_oo() (time.Time, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_oo() (time.Time, error) {
+	if u._variant != _myUnionVariant_oo {
+		var zero time.Time
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "oo", Got: u._variant.String()}
+	}
+	return u._inner.oo, nil
+}
+
+func (u *MyUnionUnion) Ptr_oo() *time.Time {
+	if u._variant != _myUnionVariant_oo {
+		return nil
+	}
+	return &u._inner.oo
+}
+
 func NewMyUnionUnion_oo(val time.Time) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{oo: val},
@@ -1228,13 +2134,20 @@ func NewMyUnionUnion_oo(val time.Time) MyUnionUnion {
 	}
 }
 
+func (u *MyUnionUnion) Set_oo(val time.Time) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{oo: val},
+		_variant: _myUnionVariant_oo,
+	}
+}
+
 func (u *MyUnionUnion) Is_pp() bool {
 	return u._variant == _myUnionVariant_pp
 }
 
 func (u *MyUnionUnion) Unwrap_pp() time.Duration {
 	if u._variant != _myUnionVariant_pp {
-		panic("called Unwrap_pp on wrong variant")
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "pp", Got: u._variant.String()})
 	}
 	return u._inner.pp
 }
@@ -1247,6 +2160,21 @@ func (u *MyUnionUnion) Get_pp() (time.Duration, bool) {
 	return zero, false
 }
 
+func (u *MyUnionUnion) Try_pp() (time.Duration, error) {
+	if u._variant != _myUnionVariant_pp {
+		var zero time.Duration
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "pp", Got: u._variant.String()}
+	}
+	return u._inner.pp, nil
+}
+
+func (u *MyUnionUnion) Ptr_pp() *time.Duration {
+	if u._variant != _myUnionVariant_pp {
+		return nil
+	}
+	return &u._inner.pp
+}
+
 func NewMyUnionUnion_pp(val time.Duration) MyUnionUnion {
 	return MyUnionUnion{
 		_inner:   myUnion{pp: val},
@@ -1254,7 +2182,110 @@ func NewMyUnionUnion_pp(val time.Duration) MyUnionUnion {
 	}
 }
 
-func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(string) _R, on_c func(*float64) _R, on_d func([]*int) _R, on_e func([5]byte) _R, on_f func(map[string][]int) _R, on_g func(map[int]map[string]bool) _R, on_h func(chan int) _R, on_i func(<-chan string) _R, on_j func(chan<- bool) _R, on_k func(func()) _R, on_l func(func(a int, b string) (int, error)) _R, on_m func(func(format string, args ...any) string) _R, on_n func(func(x int, y int) (sum int, diff int)) _R, on_o func(func(multiplier int) func(int) int) _R, on_p func(func(callback func(int) bool) error) _R, on_q func(func(in <-chan int, out chan<- int)) _R, on_r func(func(ctx context.Context) error) _R, on_s func(func(w io.Writer, r io.Reader) (int64, error)) _R, on_t func(*func(int) int) _R, on_u func([]func() error) _R, on_v func(map[string]func(int) int) _R, on_w func(***int) _R, on_x func(*[]*[3]int) _R, on_y func(func(*int, **string) *bool) _R, on_z func(any) _R, on_aa func([]any) _R, on_bb func(map[any]any) _R, on_cc func(func() (int, int, error, error)) _R, on_dd func(func(...string)) _R, on_ee func(func(func(func(int) int) func(int) int) func(func(int) int) func(int) int) _R, on_ff func(rune) _R, on_gg func(int32) _R, on_hh func(byte) _R, on_ii func(uint8) _R, on_jj func(Generic[int]) _R, on_kk func(Generic[string]) _R, on_ll func(Generic[*float64]) _R, on_mm func(TwoParam[int, string]) _R, on_nn func(Generic[Generic[int]]) _R, on_oo func(time.Time) _R, on_pp func(time.Duration) _R, on_Invalid func() _R) _R {
+func (u *MyUnionUnion) Set_pp(val time.Duration) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{pp: val},
+		_variant: _myUnionVariant_pp,
+	}
+}
+
+func (u *MyUnionUnion) Is_qq() bool {
+	return u._variant == _myUnionVariant_qq
+}
+
+func (u *MyUnionUnion) Unwrap_qq() ids {
+	if u._variant != _myUnionVariant_qq {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "qq", Got: u._variant.String()})
+	}
+	return u._inner.qq
+}
+
+func (u *MyUnionUnion) Get_qq() (ids, bool) {
+	if u._variant == _myUnionVariant_qq {
+		return u._inner.qq, true
+	}
+	var zero ids
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_qq() (ids, error) {
+	if u._variant != _myUnionVariant_qq {
+		var zero ids
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "qq", Got: u._variant.String()}
+	}
+	return u._inner.qq, nil
+}
+
+func (u *MyUnionUnion) Ptr_qq() *ids {
+	if u._variant != _myUnionVariant_qq {
+		return nil
+	}
+	return &u._inner.qq
+}
+
+func NewMyUnionUnion_qq(val ids) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{qq: val},
+		_variant: _myUnionVariant_qq,
+	}
+}
+
+func (u *MyUnionUnion) Set_qq(val ids) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{qq: val},
+		_variant: _myUnionVariant_qq,
+	}
+}
+
+func (u *MyUnionUnion) Is_rr() bool {
+	return u._variant == _myUnionVariant_rr
+}
+
+func (u *MyUnionUnion) Unwrap_rr() instant {
+	if u._variant != _myUnionVariant_rr {
+		panic(&gunion.WrongVariantError{Union: "MyUnionUnion", Want: "rr", Got: u._variant.String()})
+	}
+	return u._inner.rr
+}
+
+func (u *MyUnionUnion) Get_rr() (instant, bool) {
+	if u._variant == _myUnionVariant_rr {
+		return u._inner.rr, true
+	}
+	var zero instant
+	return zero, false
+}
+
+func (u *MyUnionUnion) Try_rr() (instant, error) {
+	if u._variant != _myUnionVariant_rr {
+		var zero instant
+		return zero, &gunion.WrongVariantError{Union: "MyUnionUnion", Want: "rr", Got: u._variant.String()}
+	}
+	return u._inner.rr, nil
+}
+
+func (u *MyUnionUnion) Ptr_rr() *instant {
+	if u._variant != _myUnionVariant_rr {
+		return nil
+	}
+	return &u._inner.rr
+}
+
+func NewMyUnionUnion_rr(val instant) MyUnionUnion {
+	return MyUnionUnion{
+		_inner:   myUnion{rr: val},
+		_variant: _myUnionVariant_rr,
+	}
+}
+
+func (u *MyUnionUnion) Set_rr(val instant) {
+	*u = MyUnionUnion{
+		_inner:   myUnion{rr: val},
+		_variant: _myUnionVariant_rr,
+	}
+}
+
+func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(string) _R, on_c func(*float64) _R, on_d func([]*int) _R, on_e func([5]byte) _R, on_f func(map[string][]int) _R, on_g func(map[int]map[string]bool) _R, on_h func(chan int) _R, on_i func(<-chan string) _R, on_j func(chan<- bool) _R, on_k func(func()) _R, on_l func(func(a int, b string) (int, error)) _R, on_m func(func(format string, args ...any) string) _R, on_n func(func(x int, y int) (sum int, diff int)) _R, on_o func(func(multiplier int) func(int) int) _R, on_p func(func(callback func(int) bool) error) _R, on_q func(func(in <-chan int, out chan<- int)) _R, on_r func(func(ctx context.Context) error) _R, on_s func(func(w io.Writer, r io.Reader) (int64, error)) _R, on_t func(*func(int) int) _R, on_u func([]func() error) _R, on_v func(map[string]func(int) int) _R, on_w func(***int) _R, on_x func(*[]*[3]int) _R, on_y func(func(*int, **string) *bool) _R, on_z func(any) _R, on_aa func([]any) _R, on_bb func(map[any]any) _R, on_cc func(func() (int, int, error, error)) _R, on_dd func(func(...string)) _R, on_ee func(func(func(func(int) int) func(int) int) func(func(int) int) func(int) int) _R, on_ff func(rune) _R, on_gg func(int32) _R, on_hh func(byte) _R, on_ii func(uint8) _R, on_jj func(Generic[int]) _R, on_kk func(Generic[string]) _R, on_ll func(Generic[*float64]) _R, on_mm func(TwoParam[int, string]) _R, on_nn func(Generic[Generic[int]]) _R, on_oo func(time.Time) _R, on_pp func(time.Duration) _R, on_qq func(ids) _R, on_rr func(instant) _R, on_Invalid func() _R) _R {
 	switch u._variant {
 	case _myUnionVariant_a:
 		return on_a(u._inner.a)
@@ -1340,6 +2371,10 @@ func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_a func(int) _R, on_b func(st
 		return on_oo(u._inner.oo)
 	case _myUnionVariant_pp:
 		return on_pp(u._inner.pp)
+	case _myUnionVariant_qq:
+		return on_qq(u._inner.qq)
+	case _myUnionVariant_rr:
+		return on_rr(u._inner.rr)
 	case _myUnionVariant_Invalid:
 		return on_Invalid()
 	default:
@@ -37,12 +37,12 @@ var Representation = types.Named{
 			// Function with params and returns
 			{Var: types.Var{Name: "l", Type: types.Signature{
 				Params:  []types.Var{{Name: "a", Type: types.Basic{Name: "int"}}, {Name: "b", Type: types.Basic{Name: "string"}}},
-				Returns: []types.Var{{Type: types.Basic{Name: "int"}}, {Type: types.Named{Name: "error"}}},
+				Returns: []types.Var{{Type: types.Basic{Name: "int"}}, {Type: types.Named{Name: "error", Interface: true}}},
 			}}},
 
 			// Variadic function
 			{Var: types.Var{Name: "m", Type: types.Signature{
-				Params:   []types.Var{{Name: "format", Type: types.Basic{Name: "string"}}, {Name: "args", Type: types.Slice{Elem: types.Named{Name: "any"}}}},
+				Params:   []types.Var{{Name: "format", Type: types.Basic{Name: "string"}}, {Name: "args", Type: types.Slice{Elem: types.Named{Name: "any", Interface: true}}}},
 				Returns:  []types.Var{{Type: types.Basic{Name: "string"}}},
 				Variadic: true,
 			}}},
@@ -68,7 +68,7 @@ var Representation = types.Named{
 					Params:  []types.Var{{Type: types.Basic{Name: "int"}}},
 					Returns: []types.Var{{Type: types.Basic{Name: "bool"}}},
 				}}},
-				Returns: []types.Var{{Type: types.Named{Name: "error"}}},
+				Returns: []types.Var{{Type: types.Named{Name: "error", Interface: true}}},
 			}}},
 
 			// Function with channel params
@@ -81,12 +81,12 @@ var Representation = types.Named{
 
 			// Function with external types
 			{Var: types.Var{Name: "r", Type: types.Signature{
-				Params:  []types.Var{{Name: "ctx", Type: types.Named{Name: "Context", Package: "context"}}},
-				Returns: []types.Var{{Type: types.Named{Name: "error"}}},
+				Params:  []types.Var{{Name: "ctx", Type: types.Named{Name: "Context", Package: "context", Interface: true}}},
+				Returns: []types.Var{{Type: types.Named{Name: "error", Interface: true}}},
 			}}},
 			{Var: types.Var{Name: "s", Type: types.Signature{
-				Params:  []types.Var{{Name: "w", Type: types.Named{Name: "Writer", Package: "io"}}, {Name: "r", Type: types.Named{Name: "Reader", Package: "io"}}},
-				Returns: []types.Var{{Type: types.Basic{Name: "int64"}}, {Type: types.Named{Name: "error"}}},
+				Params:  []types.Var{{Name: "w", Type: types.Named{Name: "Writer", Package: "io", Interface: true}}, {Name: "r", Type: types.Named{Name: "Reader", Package: "io", Interface: true}}},
+				Returns: []types.Var{{Type: types.Basic{Name: "int64"}}, {Type: types.Named{Name: "error", Interface: true}}},
 			}}},
 
 			// Pointer to function
@@ -97,7 +97,7 @@ var Representation = types.Named{
 
 			// Slice of functions
 			{Var: types.Var{Name: "u", Type: types.Slice{Elem: types.Signature{
-				Returns: []types.Var{{Type: types.Named{Name: "error"}}},
+				Returns: []types.Var{{Type: types.Named{Name: "error", Interface: true}}},
 			}}}},
 
 			// Map with function values
@@ -122,13 +122,13 @@ var Representation = types.Named{
 			}}},
 
 			// any type
-			{Var: types.Var{Name: "z", Type: types.Named{Name: "any"}}},
-			{Var: types.Var{Name: "aa", Type: types.Slice{Elem: types.Named{Name: "any"}}}},
-			{Var: types.Var{Name: "bb", Type: types.Map{Key: types.Named{Name: "any"}, Value: types.Named{Name: "any"}}}},
+			{Var: types.Var{Name: "z", Type: types.Named{Name: "any", Interface: true}}},
+			{Var: types.Var{Name: "aa", Type: types.Slice{Elem: types.Named{Name: "any", Interface: true}}}},
+			{Var: types.Var{Name: "bb", Type: types.Map{Key: types.Named{Name: "any", Interface: true}, Value: types.Named{Name: "any", Interface: true}}}},
 
 			// Multiple returns
 			{Var: types.Var{Name: "cc", Type: types.Signature{
-				Returns: []types.Var{{Type: types.Basic{Name: "int"}}, {Type: types.Basic{Name: "int"}}, {Type: types.Named{Name: "error"}}, {Type: types.Named{Name: "error"}}},
+				Returns: []types.Var{{Type: types.Basic{Name: "int"}}, {Type: types.Basic{Name: "int"}}, {Type: types.Named{Name: "error", Interface: true}}, {Type: types.Named{Name: "error", Interface: true}}},
 			}}},
 
 			// Variadic only
@@ -159,43 +159,55 @@ var Representation = types.Named{
 			{Var: types.Var{Name: "jj", Type: types.Named{
 				Name:       "Generic",
 				Package:    "github.com/sidkurella/gunion/internal/testdata/torture",
-				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any"}}},
+				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any", Interface: true}}},
 				TypeArgs:   []types.Type{types.Basic{Name: "int"}},
 			}}},
 			{Var: types.Var{Name: "kk", Type: types.Named{
 				Name:       "Generic",
 				Package:    "github.com/sidkurella/gunion/internal/testdata/torture",
-				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any"}}},
+				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any", Interface: true}}},
 				TypeArgs:   []types.Type{types.Basic{Name: "string"}},
 			}}},
 			{Var: types.Var{Name: "ll", Type: types.Named{
 				Name:       "Generic",
 				Package:    "github.com/sidkurella/gunion/internal/testdata/torture",
-				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any"}}},
+				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any", Interface: true}}},
 				TypeArgs:   []types.Type{types.Pointer{Elem: types.Basic{Name: "float64"}}},
 			}}},
 			{Var: types.Var{Name: "mm", Type: types.Named{
 				Name:    "TwoParam",
 				Package: "github.com/sidkurella/gunion/internal/testdata/torture",
 				TypeParams: []types.TypeParam{
-					{Name: "K", Constraint: types.Named{Name: "comparable"}},
-					{Name: "V", Constraint: types.Named{Name: "any"}},
+					{Name: "K", Constraint: types.Named{Name: "comparable", Interface: true}},
+					{Name: "V", Constraint: types.Named{Name: "any", Interface: true}},
 				},
 				TypeArgs: []types.Type{types.Basic{Name: "int"}, types.Basic{Name: "string"}},
 			}}},
 			{Var: types.Var{Name: "nn", Type: types.Named{
 				Name:       "Generic",
 				Package:    "github.com/sidkurella/gunion/internal/testdata/torture",
-				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any"}}},
+				TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any", Interface: true}}},
 				TypeArgs: []types.Type{types.Named{
 					Name:       "Generic",
 					Package:    "github.com/sidkurella/gunion/internal/testdata/torture",
-					TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any"}}},
+					TypeParams: []types.TypeParam{{Name: "T", Constraint: types.Named{Name: "any", Interface: true}}},
 					TypeArgs:   []types.Type{types.Basic{Name: "int"}},
 				}},
 			}}},
-			{Var: types.Var{Name: "oo", Type: types.Named{Name: "Time", Package: "time"}}},
+			{Var: types.Var{Name: "oo", Type: types.Named{Name: "Time", Package: "time", HasEqual: true}}},
 			{Var: types.Var{Name: "pp", Type: types.Named{Name: "Duration", Package: "time"}}},
+
+			// Aliases
+			{Var: types.Var{Name: "qq", Type: types.Named{
+				Name:         "ids",
+				Package:      "github.com/sidkurella/gunion/internal/testdata/torture",
+				Incomparable: true,
+			}}},
+			{Var: types.Var{Name: "rr", Type: types.Named{
+				Name:     "instant",
+				Package:  "github.com/sidkurella/gunion/internal/testdata/torture",
+				HasEqual: true,
+			}}},
 		},
 	},
 }
@@ -109,8 +109,16 @@ type myUnion struct {
 	nn Generic[Generic[int]] // nested generic instantiation
 	oo time.Time             // external non-generic (for comparison)
 	pp time.Duration         // external named basic type (int64 underlying)
+
+	// Aliases keep their name, but are compared like the aliased type
+	qq ids     // alias for an incomparable slice
+	rr instant // alias for a type with an Equal method
 }
 
+type ids = []int
+
+type instant = time.Time
+
 // Generic is a generic type for testing instantiation.
 type Generic[T any] struct {
 	value T
@@ -0,0 +1,16 @@
+package unit
+
+import "github.com/sidkurella/gunion/internal/types"
+
+// Representation is the parsed type representation of myUnion.
+var Representation = types.Named{
+	Name:    "myUnion",
+	Package: "github.com/sidkurella/gunion/internal/testdata/unit",
+	Type: types.Struct{
+		Fields: []types.Field{
+			{Var: types.Var{Name: "a", Type: types.Basic{Name: "int"}}},
+			{Var: types.Var{Name: "none", Type: types.Struct{}}},
+			{Var: types.Var{Name: "b", Type: types.Basic{Name: "string"}}},
+		},
+	},
+}
@@ -0,0 +1,7 @@
+package unit
+
+type myUnion struct {
+	a    int
+	none struct{}
+	b    string
+}
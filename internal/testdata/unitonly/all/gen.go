@@ -0,0 +1,242 @@
+// Code generated by gunion via `gunion --type myUnion --src source.go --no-default --kind --equal --slog --visitor --match-or --match-e --switch --json adjacent`. DO NOT EDIT.
+
+package unitonly
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+type _myUnionVariant int
+
+const (
+	_myUnionVariant_Invalid _myUnionVariant = 0
+	_myUnionVariant_on      _myUnionVariant = 1
+	_myUnionVariant_off     _myUnionVariant = 2
+)
+
+func (v _myUnionVariant) String() string {
+	switch v {
+	case _myUnionVariant_Invalid:
+		return "Invalid"
+	case _myUnionVariant_on:
+		return "on"
+	case _myUnionVariant_off:
+		return "off"
+	default:
+		return "unknown"
+	}
+}
+
+type MyUnionUnion struct {
+	_variant _myUnionVariant
+	_inner   myUnion
+}
+
+func (u *MyUnionUnion) Is_Invalid() bool {
+	return u._variant == _myUnionVariant_Invalid
+}
+
+func NewMyUnionUnion_Invalid() MyUnionUnion {
+	return MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion) Set_Invalid() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_Invalid}
+}
+
+func (u *MyUnionUnion) Is_on() bool {
+	return u._variant == _myUnionVariant_on
+}
+
+func NewMyUnionUnion_on() MyUnionUnion {
+	return MyUnionUnion{_variant: _myUnionVariant_on}
+}
+
+func (u *MyUnionUnion) Set_on() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_on}
+}
+
+func (u *MyUnionUnion) Is_off() bool {
+	return u._variant == _myUnionVariant_off
+}
+
+func NewMyUnionUnion_off() MyUnionUnion {
+	return MyUnionUnion{_variant: _myUnionVariant_off}
+}
+
+func (u *MyUnionUnion) Set_off() {
+	*u = MyUnionUnion{_variant: _myUnionVariant_off}
+}
+
+func Match_MyUnionUnion[_R any](u *MyUnionUnion, on_on func() _R, on_off func() _R, on_Invalid func() _R) _R {
+	switch u._variant {
+	case _myUnionVariant_on:
+		return on_on()
+	case _myUnionVariant_off:
+		return on_off()
+	case _myUnionVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func MatchE_MyUnionUnion[_R any](u *MyUnionUnion, on_on func() (_R, error), on_off func() (_R, error), on_Invalid func() (_R, error)) (_R, error) {
+	switch u._variant {
+	case _myUnionVariant_on:
+		return on_on()
+	case _myUnionVariant_off:
+		return on_off()
+	case _myUnionVariant_Invalid:
+		return on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+func Switch_MyUnionUnion(u *MyUnionUnion, on_on func(), on_off func(), on_Invalid func()) {
+	switch u._variant {
+	case _myUnionVariant_on:
+		on_on()
+	case _myUnionVariant_off:
+		on_off()
+	case _myUnionVariant_Invalid:
+		on_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+type MyUnionUnionArms[_R any] struct {
+	On_on      func() _R
+	On_off     func() _R
+	On_Invalid func() _R
+}
+
+func MatchOr_MyUnionUnion[_R any](u *MyUnionUnion, arms MyUnionUnionArms[_R], fallback func() _R) _R {
+	switch u._variant {
+	case _myUnionVariant_on:
+		if arms.On_on != nil {
+			return arms.On_on()
+		}
+	case _myUnionVariant_off:
+		if arms.On_off != nil {
+			return arms.On_off()
+		}
+	case _myUnionVariant_Invalid:
+		if arms.On_Invalid != nil {
+			return arms.On_Invalid()
+		}
+	}
+	return fallback()
+}
+
+type MyUnionUnionVisitor[_R any] interface {
+	Visit_on() _R
+	Visit_off() _R
+	Visit_Invalid() _R
+}
+
+func Accept_MyUnionUnion[_R any](u *MyUnionUnion, v MyUnionUnionVisitor[_R]) _R {
+	switch u._variant {
+	case _myUnionVariant_on:
+		return v.Visit_on()
+	case _myUnionVariant_off:
+		return v.Visit_off()
+	case _myUnionVariant_Invalid:
+		return v.Visit_Invalid()
+	default:
+		panic("unreachable")
+	}
+}
+
+type MyUnionUnionKind int
+
+const (
+	MyUnionUnionKindInvalid MyUnionUnionKind = 0
+	MyUnionUnionKindOn      MyUnionUnionKind = 1
+	MyUnionUnionKindOff     MyUnionUnionKind = 2
+)
+
+func (k MyUnionUnionKind) String() string {
+	return _myUnionVariant(k).String()
+}
+
+func (u *MyUnionUnion) Kind() MyUnionUnionKind {
+	return MyUnionUnionKind(u._variant)
+}
+
+func Variants_MyUnionUnion() []MyUnionUnionKind {
+	return []MyUnionUnionKind{MyUnionUnionKindOn, MyUnionUnionKindOff}
+}
+
+func (u *MyUnionUnion) Equal(other *MyUnionUnion) bool {
+	if u._variant != other._variant {
+		return false
+	}
+	switch u._variant {
+	default:
+		return true
+	}
+}
+
+func (u MyUnionUnion) LogValue() slog.Value {
+	switch u._variant {
+	default:
+		return slog.GroupValue(slog.String("variant", u._variant.String()))
+	}
+}
+
+func (u MyUnionUnion) MarshalJSON() ([]byte, error) {
+	var tag string
+	var value any
+	switch u._variant {
+	case _myUnionVariant_on:
+		tag, value = "on", u._inner.on
+	case _myUnionVariant_off:
+		tag, value = "off", u._inner.off
+	default:
+		return nil, fmt.Errorf("cannot marshal MyUnionUnion: variant %s has no JSON representation", u._variant)
+	}
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}{tag, value})
+}
+
+func (u *MyUnionUnion) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: %w", err)
+	}
+	tag, payload := envelope.Type, envelope.Value
+	switch tag {
+	case "Invalid":
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: variant %q has no JSON representation", tag)
+	case "on":
+		var val struct{}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &val); err != nil {
+				return fmt.Errorf("cannot unmarshal MyUnionUnion variant on: %w", err)
+			}
+		}
+		*u = MyUnionUnion{_variant: _myUnionVariant_on}
+		return nil
+	case "off":
+		var val struct{}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &val); err != nil {
+				return fmt.Errorf("cannot unmarshal MyUnionUnion variant off: %w", err)
+			}
+		}
+		*u = MyUnionUnion{_variant: _myUnionVariant_off}
+		return nil
+	default:
+		return fmt.Errorf("cannot unmarshal MyUnionUnion: unknown variant %q", tag)
+	}
+}
@@ -0,0 +1,7 @@
+package unitonly
+
+// myUnion has only unit variants, so no generated code may depend on a payload being present.
+type myUnion struct {
+	on  struct{}
+	off struct{}
+}
@@ -36,6 +36,14 @@ type Named struct {
 	TypeParams []TypeParam
 	// Concrete type arguments provided to instantiate the named type, if any.
 	TypeArgs []Type
+	// Values of this type can't be compared with ==. Only set for named types nested in the loaded type.
+	Incomparable bool
+	// Values of this type are interfaces, which panic when compared with == if their dynamic types aren't
+	// comparable. Only set for named types nested in the loaded type.
+	Interface bool
+	// This type has an Equal method taking a value of the same type and returning a bool.
+	// Only set for named types nested in the loaded type.
+	HasEqual bool
 }
 
 // Represents an array with fixed size.